@@ -2,18 +2,23 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"wegugin/config"
 	pb "wegugin/genproto/cruds"
+	"wegugin/jobs"
 	"wegugin/logs"
 	"wegugin/middleware"
 	"wegugin/service"
+	"wegugin/storage/blob"
 	"wegugin/storage/postgres"
+	"wegugin/upload"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/gin-gonic/gin"
@@ -24,7 +29,7 @@ import (
 
 func runGatewayServer(grpcAddr string) {
 	ctx := context.Background()
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(headerMatcher))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
 	// 1. Casbin Enforcer ni ishga tushirish
@@ -49,6 +54,49 @@ func runGatewayServer(grpcAddr string) {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
 
+	// Rasm yuklash: multipart fayl BlobStore ga yoziladi, yozuv gRPC orqali yaratiladi.
+	// Marshrut gateway mux ga qo'shiladi, shuning uchun auth middleware undan ham o'tadi
+	blobCfg := config.Load().Blob
+	store, err := blob.New(ctx, blobCfg)
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+	conn, err := grpc.NewClient(grpcAddr, opts...)
+	if err != nil {
+		log.Fatalf("Failed to dial gRPC server: %v", err)
+	}
+	// Imzolangan URL lar lokal saqlashda servisning o'zida tekshiriladi, S3 da esa bucket tomonidan
+	var verifier *blob.HMACSigner
+	if blobCfg.BLOB_DRIVER == blob.DriverLocal {
+		verifier = blob.NewHMACSigner(blobCfg.BLOB_PUBLIC_URL, blobCfg.BLOB_SIGNING_KEY)
+	}
+	uploads := upload.NewHandler(mux, store, pb.NewCrudsServiceClient(conn), logs.NewLogger(),
+		blobCfg.UPLOAD_MAX_BYTES, blobCfg.UPLOAD_MAX_DIMENSION, verifier,
+		blobCfg.UPLOAD_MAX_VIDEO_BYTES, blobCfg.UPLOAD_MAX_DOCUMENT_BYTES)
+	if err := mux.HandlePath(http.MethodPost, "/v1/cars/{car_id}/images/upload", uploads.UploadCarImage); err != nil {
+		log.Fatalf("Failed to register upload handler: %v", err)
+	}
+	if err := mux.HandlePath(http.MethodPost, "/v1/cars/{car_id}/images/confirm", uploads.ConfirmUpload); err != nil {
+		log.Fatalf("Failed to register confirm handler: %v", err)
+	}
+	if err := mux.HandlePath(http.MethodPost, "/v1/cars/{car_id}/media/upload", uploads.UploadCarMedia); err != nil {
+		log.Fatalf("Failed to register media upload handler: %v", err)
+	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/files/{key=**}", uploads.ServeFile); err != nil {
+		log.Fatalf("Failed to register file handler: %v", err)
+	}
+	if err := mux.HandlePath(http.MethodPut, "/v1/files/{key=**}", uploads.PutFile); err != nil {
+		log.Fatalf("Failed to register direct upload handler: %v", err)
+	}
+
+	// Fon joblari ko'rsatkichlari (expvar), casbin bo'yicha faqat admin uchun
+	metrics := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		expvar.Handler().ServeHTTP(w, r)
+	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/metrics", metrics); err != nil {
+		log.Fatalf("Failed to register metrics handler: %v", err)
+	}
+
 	// Gin serverini sozlash
 	r := gin.Default()
 	r.Any("/v1/*any", gin.WrapH(wrappedMux))
@@ -62,6 +110,14 @@ func runGatewayServer(grpcAddr string) {
 	}
 }
 
+// headerMatcher - Accept-Language ni gRPC metadata ga o'z nomi bilan uzatish (tavsif tilini tanlash uchun)
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "Accept-Language") {
+		return "accept-language", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func runGRPCServer(store postgres.Store) {
 
 	listener, err := net.Listen("tcp", config.Load().Server.CRUD_SERVICE)
@@ -79,11 +135,40 @@ func runGRPCServer(store postgres.Store) {
 
 func main() {
 	ctx := context.Background()
+
+	// Lokal saqlashda private/ fayllar va to'g'ridan-to'g'ri yuklash faqat HMAC imzo bilan himoyalangan,
+	// ochiq standart kalit bilan har kim URL soxtalashtira oladi
+	if blobCfg := config.Load().Blob; blobCfg.BLOB_DRIVER == blob.DriverLocal &&
+		(blobCfg.BLOB_SIGNING_KEY == "" || blobCfg.BLOB_SIGNING_KEY == config.DefaultBlobSigningKey) {
+		log.Fatalf("BLOB_SIGNING_KEY must be set to a secret value when BLOB_DRIVER=%s", blob.DriverLocal)
+	}
+
 	store, err := postgres.ConnectionDb(ctx)
 	if err != nil {
 		log.Fatalf("Failed to initialize store: %v", err)
 	}
 
+	// O'chirilgan yozuvlarni muddati o'tgach tozalash
+	trash := config.Load().Trash
+	purger := jobs.NewTrashPurger(store, logs.NewLogger(),
+		time.Duration(trash.TRASH_RETENTION_DAYS)*24*time.Hour, trash.TRASH_PURGE_INTERVAL)
+	go purger.Run(ctx)
+
+	// Qidiruv takliflari indeksini fonda yangilash
+	search := config.Load().Search
+	indexer := jobs.NewSuggestionIndexer(store, logs.NewLogger(), search.SEARCH_TRENDING_WINDOW,
+		time.Duration(search.SEARCH_QUERY_RETENTION_DAYS)*24*time.Hour, search.SEARCH_SUGGEST_REFRESH_INTERVAL)
+	go indexer.Run(ctx)
+
+	// Hech bir yozuvga tegishli bo'lmagan fayllarni saqlash qatlamidan tozalash
+	blobCfg := config.Load().Blob
+	blobs, err := blob.New(ctx, blobCfg)
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+	gc := jobs.NewBlobGC(store, blobs, logs.NewLogger(), blobCfg.BLOB_GC_GRACE, blobCfg.BLOB_GC_INTERVAL, blobCfg.BLOB_GC_DRY_RUN)
+	go gc.Run(ctx)
+
 	go func() {
 		runGRPCServer(store)
 	}()
@@ -3,18 +3,25 @@ package config
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
 )
 
 type Config struct {
-	Postgres PostgresConfig
-	Server   ServerConfig
-	Mongo    MongoDBConfig
-	Redis    RedisConfig
-	Kafka    KafkaConfig
-	Token    Token
+	Postgres   PostgresConfig
+	Server     ServerConfig
+	Mongo      MongoDBConfig
+	Redis      RedisConfig
+	Kafka      KafkaConfig
+	Token      Token
+	Trash      TrashConfig
+	Moderation ModerationConfig
+	Reports    ReportsConfig
+	Comments   CommentsConfig
+	Search     SearchConfig
+	Blob       BlobConfig
 }
 
 type PostgresConfig struct {
@@ -33,6 +40,9 @@ type RedisConfig struct {
 type ServerConfig struct {
 	CRUD_SERVICE string
 	CRUD_SERVER  string
+	// X-Forwarded-For ga ishoniladigan manzillar (IP yoki CIDR, vergul bilan): gRPC ga ulanadigan
+	// gateway va undan oldingi proksi/balanser. Boshqa mijozlar yuborgan sarlavha e'tiborsiz qoladi
+	TRUSTED_PROXIES string
 }
 
 type MongoDBConfig struct {
@@ -48,6 +58,75 @@ type Token struct {
 	TOKEN_KEY string
 }
 
+type ModerationConfig struct {
+	// "pre" - e'lon va kommentariyalar admin tasdiqlagandan keyin ko'rinadi,
+	// "post" - darhol ko'rinadi va keyin tekshiriladi
+	MODERATION_MODE string
+}
+
+type ReportsConfig struct {
+	// Shuncha turli foydalanuvchi shikoyat qilsa, kontent avtomatik yashiriladi
+	REPORT_HIDE_THRESHOLD int
+}
+
+type CommentsConfig struct {
+	// Javoblarning maksimal chuqurligi (0 - faqat ildiz kommentariyalar)
+	COMMENT_MAX_DEPTH int
+}
+
+type SearchConfig struct {
+	// Takliflar indeksi qanchalik tez-tez qayta quriladi
+	SEARCH_SUGGEST_REFRESH_INTERVAL time.Duration
+	// Trend va takliflar uchun hisobga olinadigan so'rovlar davri
+	SEARCH_TRENDING_WINDOW time.Duration
+	// Trendga chiqishi uchun so'rovni kamida shuncha turli foydalanuvchi (yoki IP) izlagan bo'lishi kerak
+	SEARCH_TRENDING_MIN_SEARCHERS int
+	// So'rovlar jurnali shuncha kundan keyin o'chiriladi
+	SEARCH_QUERY_RETENTION_DAYS int
+}
+
+type BlobConfig struct {
+	// "local" - fayl tizimi, "s3" - S3 bilan mos saqlash (AWS S3, MinIO)
+	BLOB_DRIVER    string
+	BLOB_LOCAL_DIR string
+	S3_ENDPOINT    string
+	S3_REGION      string
+	S3_BUCKET      string
+	S3_ACCESS_KEY  string
+	S3_SECRET_KEY  string
+	// Mijozlarga beriladigan imzolangan URL lar uchun S3 manzili (bo'sh bo'lsa S3_ENDPOINT)
+	S3_PUBLIC_ENDPOINT string
+	// Fayllar URL i uchun asos (CDN yoki bucket manzili, standart - servisning o'zi)
+	BLOB_PUBLIC_URL string
+	// Yuklanadigan bitta faylning maksimal hajmi (bayt)
+	UPLOAD_MAX_BYTES int64
+	// Yuklanadigan rasmning har bir tomoni uchun maksimal piksel
+	UPLOAD_MAX_DIMENSION int
+	// Yuklanadigan video va hujjatlarning maksimal hajmi (bayt)
+	UPLOAD_MAX_VIDEO_BYTES    int64
+	UPLOAD_MAX_DOCUMENT_BYTES int64
+	// Lokal saqlashdagi imzolangan URL lar uchun HMAC kaliti
+	BLOB_SIGNING_KEY string
+	// Imzolangan yuklash va yuklab olish URL larining amal qilish muddati
+	SIGNED_URL_TTL time.Duration
+	// Yetim fayllarni tozalash jobi qanchalik tez-tez ishga tushishi
+	BLOB_GC_INTERVAL time.Duration
+	// Fayl shundan yoshroq bo'lsa tegilmaydi (yuklash hali tugamagan bo'lishi mumkin)
+	BLOB_GC_GRACE time.Duration
+	// true bo'lsa hech narsa o'chirilmaydi, faqat hisobot yoziladi
+	BLOB_GC_DRY_RUN bool
+}
+
+type TrashConfig struct {
+	// O'chirilgan yozuvlar shuncha kundan keyin butunlay o'chiriladi
+	TRASH_RETENTION_DAYS int
+	// Purge job qanchalik tez-tez ishga tushishi
+	TRASH_PURGE_INTERVAL time.Duration
+}
+
+// DefaultBlobSigningKey - BLOB_SIGNING_KEY berilmaganda ishlatiladigan ochiq kalit (faqat lokal ishlab chiqish uchun)
+const DefaultBlobSigningKey = "my-blob-signing-key"
+
 func Load() *Config {
 	if err := godotenv.Load(".env"); err != nil {
 		log.Printf("error while loading .env file: %v", err)
@@ -66,6 +145,8 @@ func Load() *Config {
 			CRUD_SERVICE: getGRPCPort("CRUD_SERVICE", "50051"),
 			// HTTP server should use Railway's PORT if available
 			CRUD_SERVER:  getHTTPPort("CRUD_SERVER", "8090"),
+			// Gateway gRPC servisga shu jarayondan loopback orqali ulanadi
+			TRUSTED_PROXIES: cast.ToString(coalesce("TRUSTED_PROXIES", "127.0.0.0/8,::1/128")),
 		},
 		Mongo: MongoDBConfig{
 			MDB_ADDRESS: cast.ToString(coalesce("MDB_ADDRESS", "mongodb://localhost:27017")),
@@ -77,6 +158,45 @@ func Load() *Config {
 		Token: Token{
 			TOKEN_KEY: cast.ToString(coalesce("TOKEN_KEY", "my-secret-key")),
 		},
+		Moderation: ModerationConfig{
+			MODERATION_MODE: cast.ToString(coalesce("MODERATION_MODE", "post")),
+		},
+		Reports: ReportsConfig{
+			REPORT_HIDE_THRESHOLD: cast.ToInt(coalesce("REPORT_HIDE_THRESHOLD", 3)),
+		},
+		Comments: CommentsConfig{
+			COMMENT_MAX_DEPTH: cast.ToInt(coalesce("COMMENT_MAX_DEPTH", 3)),
+		},
+		Search: SearchConfig{
+			SEARCH_SUGGEST_REFRESH_INTERVAL: cast.ToDuration(coalesce("SEARCH_SUGGEST_REFRESH_INTERVAL", "10m")),
+			SEARCH_TRENDING_WINDOW:          cast.ToDuration(coalesce("SEARCH_TRENDING_WINDOW", "24h")),
+			SEARCH_TRENDING_MIN_SEARCHERS:   cast.ToInt(coalesce("SEARCH_TRENDING_MIN_SEARCHERS", 5)),
+			SEARCH_QUERY_RETENTION_DAYS:     cast.ToInt(coalesce("SEARCH_QUERY_RETENTION_DAYS", 90)),
+		},
+		Blob: BlobConfig{
+			BLOB_DRIVER:               cast.ToString(coalesce("BLOB_DRIVER", "local")),
+			BLOB_LOCAL_DIR:            cast.ToString(coalesce("BLOB_LOCAL_DIR", "./uploads")),
+			S3_ENDPOINT:               cast.ToString(coalesce("S3_ENDPOINT", "http://localhost:9000")),
+			S3_REGION:                 cast.ToString(coalesce("S3_REGION", "us-east-1")),
+			S3_BUCKET:                 cast.ToString(coalesce("S3_BUCKET", "wegugin")),
+			S3_ACCESS_KEY:             cast.ToString(coalesce("S3_ACCESS_KEY", "minioadmin")),
+			S3_SECRET_KEY:             cast.ToString(coalesce("S3_SECRET_KEY", "minioadmin")),
+			S3_PUBLIC_ENDPOINT:        cast.ToString(coalesce("S3_PUBLIC_ENDPOINT", "")),
+			BLOB_PUBLIC_URL:           cast.ToString(coalesce("BLOB_PUBLIC_URL", "/v1/files")),
+			UPLOAD_MAX_BYTES:          cast.ToInt64(coalesce("UPLOAD_MAX_BYTES", 10<<20)),
+			UPLOAD_MAX_DIMENSION:      cast.ToInt(coalesce("UPLOAD_MAX_DIMENSION", 8000)),
+			UPLOAD_MAX_VIDEO_BYTES:    cast.ToInt64(coalesce("UPLOAD_MAX_VIDEO_BYTES", 200<<20)),
+			UPLOAD_MAX_DOCUMENT_BYTES: cast.ToInt64(coalesce("UPLOAD_MAX_DOCUMENT_BYTES", 20<<20)),
+			BLOB_SIGNING_KEY:          cast.ToString(coalesce("BLOB_SIGNING_KEY", DefaultBlobSigningKey)),
+			SIGNED_URL_TTL:            cast.ToDuration(coalesce("SIGNED_URL_TTL", "15m")),
+			BLOB_GC_INTERVAL:          cast.ToDuration(coalesce("BLOB_GC_INTERVAL", "6h")),
+			BLOB_GC_GRACE:             cast.ToDuration(coalesce("BLOB_GC_GRACE", "24h")),
+			BLOB_GC_DRY_RUN:           cast.ToBool(coalesce("BLOB_GC_DRY_RUN", true)),
+		},
+		Trash: TrashConfig{
+			TRASH_RETENTION_DAYS: cast.ToInt(coalesce("TRASH_RETENTION_DAYS", 30)),
+			TRASH_PURGE_INTERVAL: cast.ToDuration(coalesce("TRASH_PURGE_INTERVAL", "1h")),
+		},
 	}
 }
 
@@ -32,7 +32,7 @@ var file_cruds_cruds_proto_rawDesc = string([]byte{
 	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xcb, 0x25, 0x0a, 0x0c,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x97, 0x7a, 0x0a, 0x0c,
 	0x43, 0x72, 0x75, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x09,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64,
 	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -69,284 +69,961 @@ var file_cruds_cruds_proto_rawDesc = string([]byte{
 	0x4c, 0x45, 0x54, 0x45, 0x20, 0x43, 0x41, 0x52, 0x53, 0x1a, 0x0b, 0x44, 0x45, 0x4c, 0x45, 0x54,
 	0x45, 0x20, 0x43, 0x41, 0x52, 0x53, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b,
 	0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xb3, 0x01,
-	0x0a, 0x17, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x09, 0x2e, 0x63, 0x72, 0x75, 0x64,
-	0x73, 0x2e, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x7f, 0x92, 0x41, 0x50, 0x0a, 0x04, 0x43, 0x41, 0x52, 0x53, 0x12, 0x23, 0x49,
-	0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x20,
-	0x43, 0x41, 0x52, 0x27, 0x53, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x1a, 0x23, 0x49, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x69, 0x6e, 0x63, 0x72,
-	0x65, 0x61, 0x73, 0x65, 0x20, 0x43, 0x41, 0x52, 0x27, 0x53, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x1a, 0x24, 0x2f,
-	0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0xa9, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61,
-	0x72, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x6a, 0x92, 0x41, 0x50, 0x0a, 0x04, 0x43, 0x41, 0x52, 0x53, 0x12, 0x23,
-	0x49, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x63,
-	0x61, 0x72, 0x20, 0x62, 0x79, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x6d,
-	0x61, 0x6b, 0x65, 0x1a, 0x23, 0x49, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x20, 0x63, 0x61, 0x72, 0x20, 0x62, 0x79, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f,
-	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
-	0x3a, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x12, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64,
-	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x82, 0x01, 0x0a, 0x07,
-	0x53, 0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x12, 0x15, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x53, 0x61, 0x76, 0x65, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
-	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x92, 0x41,
-	0x36, 0x0a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x0a, 0x53,
-	0x41, 0x56, 0x45, 0x20, 0x20, 0x43, 0x41, 0x52, 0x53, 0x1a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x20,
-	0x20, 0x43, 0x41, 0x52, 0x53, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
-	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73,
-	0x12, 0xaf, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72,
-	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x5f, 0x92, 0x41, 0x3c, 0x0a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41,
-	0x52, 0x53, 0x12, 0x16, 0x47, 0x65, 0x74, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61,
-	0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x47, 0x65, 0x74, 0x20,
-	0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x55, 0x73,
-	0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61,
-	0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76,
-	0x65, 0x64, 0x43, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x60, 0x92, 0x41, 0x42, 0x0a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41,
-	0x52, 0x53, 0x12, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64,
-	0x20, 0x43, 0x61, 0x72, 0x1a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76,
-	0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b,
-	0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0xb0, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61,
-	0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x49, 0x64, 0x12, 0x0c,
-	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63,
-	0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7a, 0x92, 0x41, 0x58, 0x0a,
-	0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x1b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42,
-	0x79, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49, 0x64, 0x1a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43,
-	0x61, 0x72, 0x20, 0x49, 0x64, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65,
-	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63,
-	0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x45, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x63, 0x72,
-	0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x9d, 0x01,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x12, 0x09, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x62, 0x92, 0x41, 0x42, 0x0a, 0x04, 0x43, 0x41, 0x52, 0x53,
+	0x12, 0x13, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x20, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x20, 0x43, 0x41, 0x52, 0x1a, 0x13, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x20, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x17, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x09, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x64,
+	0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7f,
+	0x92, 0x41, 0x50, 0x0a, 0x04, 0x43, 0x41, 0x52, 0x53, 0x12, 0x23, 0x49, 0x74, 0x20, 0x77, 0x69,
+	0x6c, 0x6c, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x20, 0x43, 0x41, 0x52, 0x27,
+	0x53, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x23,
+	0x49, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65,
+	0x20, 0x43, 0x41, 0x52, 0x27, 0x53, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x1a, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0xa9, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x12, 0x17, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x6a, 0x92, 0x41, 0x50, 0x0a, 0x04, 0x43, 0x41, 0x52, 0x53, 0x12, 0x23, 0x49, 0x74, 0x20, 0x77,
+	0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x63, 0x61, 0x72, 0x20, 0x62,
+	0x79, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x1a,
+	0x23, 0x49, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20,
+	0x63, 0x61, 0x72, 0x20, 0x62, 0x79, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x6f, 0x72, 0x20,
+	0x6d, 0x61, 0x6b, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x11, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x61, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x43, 0x61, 0x72, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x82, 0x01, 0x0a, 0x07, 0x53, 0x61, 0x76, 0x65,
+	0x43, 0x61, 0x72, 0x12, 0x15, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x61, 0x76, 0x65,
+	0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x92, 0x41, 0x36, 0x0a, 0x0a, 0x53,
+	0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x20,
+	0x20, 0x43, 0x41, 0x52, 0x53, 0x1a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x20, 0x20, 0x43, 0x41, 0x52,
+	0x53, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x12, 0xaf, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x43, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x92,
+	0x41, 0x3c, 0x0a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x16,
+	0x47, 0x65, 0x74, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42,
+	0x79, 0x20, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x16, 0x47, 0x65, 0x74, 0x20, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9e,
+	0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61,
+	0x72, 0x12, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x60, 0x92,
+	0x41, 0x42, 0x0a, 0x0a, 0x53, 0x41, 0x56, 0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72,
+	0x1a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43,
+	0x61, 0x72, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0xb0, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x43,
+	0x61, 0x72, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x49, 0x64, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7a, 0x92, 0x41, 0x58, 0x0a, 0x0a, 0x53, 0x41, 0x56,
+	0x45, 0x44, 0x20, 0x43, 0x41, 0x52, 0x53, 0x12, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61,
+	0x72, 0x20, 0x49, 0x64, 0x1a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x20, 0x43, 0x61, 0x72, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49,
+	0x64, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x45, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x61, 0x76, 0x65, 0x64,
+	0x43, 0x61, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x19, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x43, 0x61, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x9d, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x57, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5e, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0xd1, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x42, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0x18, 0x47, 0x65, 0x74, 0x20, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x20, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x12, 0x24, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x57, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46,
-	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xd1, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72,
-	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x24, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65,
-	0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x42, 0x0a, 0x0c, 0x4e,
-	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x18, 0x47, 0x65, 0x74,
-	0x20, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x18, 0x47, 0x65, 0x74, 0x20, 0x55, 0x6e, 0x72, 0x65, 0x61,
-	0x64, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x2f,
-	0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x16, 0x4d, 0x61,
-	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x6a, 0x92, 0x41, 0x44, 0x0a, 0x0c, 0x4e,
-	0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x19, 0x4d, 0x61, 0x72,
-	0x6b, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x41,
-	0x73, 0x20, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x20, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x41, 0x73, 0x20, 0x52, 0x65, 0x61,
-	0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
-	0x72, 0x65, 0x61, 0x64, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x72,
-	0x75, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x6a, 0x92, 0x41, 0x44, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x20, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x41, 0x73, 0x20, 0x52, 0x65,
+	0x61, 0x64, 0x1a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x41, 0x73, 0x20, 0x52, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x9f, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x59, 0x92, 0x41, 0x38, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0xbd, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x59, 0x92, 0x41, 0x38,
-	0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x2a, 0x16,
-	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42,
-	0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x17, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49,
-	0x64, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x73, 0x12, 0xdc, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x87, 0x01, 0x92, 0x41, 0x61, 0x0a, 0x13,
-	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x20, 0x54, 0x4f, 0x4b,
-	0x45, 0x4e, 0x53, 0x12, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x20, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x1a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x62, 0x10, 0x0a,
-	0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x12, 0xfa, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x57, 0x0a, 0x13,
-	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x20, 0x54, 0x4f, 0x4b,
-	0x45, 0x4e, 0x53, 0x12, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x42, 0x79, 0x20,
-	0x55, 0x73, 0x65, 0x72, 0x1a, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x42, 0x79,
-	0x20, 0x55, 0x73, 0x65, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0xc9, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x2e, 0x63, 0x72,
-	0x75, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x75, 0x92, 0x41, 0x4c,
+	0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x14,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x1a, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x79, 0x92, 0x41, 0x4b, 0x0a, 0x13, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x20, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x12, 0x19, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x30, 0x0a, 0x08, 0x41,
-	0x64, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x12,
-	0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x19, 0x2e,
-	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x49, 0x64, 0x12, 0x0c, 0x2e, 0x63,
-	0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
-	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
-	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x92, 0x41, 0x3c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d,
-	0x45, 0x4e, 0x54, 0x53, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d,
+	0x12, 0x42, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x65, 0x73, 0x12,
+	0xdc, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x87, 0x01, 0x92, 0x41, 0x61, 0x0a, 0x13, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x20, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x12,
+	0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x1b, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0xfa,
+	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x57, 0x0a, 0x13, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x20, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x12,
+	0x1f, 0x47, 0x65, 0x74, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x42, 0x79, 0x20, 0x55, 0x73, 0x65, 0x72,
+	0x1a, 0x1f, 0x47, 0x65, 0x74, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x20, 0x42, 0x79, 0x20, 0x55, 0x73, 0x65,
+	0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc9, 0x01, 0x0a, 0x17,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x79, 0x92, 0x41,
+	0x4b, 0x0a, 0x13, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x20,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x53, 0x12, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x2a, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x12, 0x0c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x31, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x49, 0x64, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0xdd, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x22, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x22,
+	0x8e, 0x02, 0x92, 0x41, 0xdc, 0x01, 0x0a, 0x06, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x12, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x20, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x1a, 0xa6, 0x01, 0x54, 0x69, 0x6d, 0x65, 0x2d, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x55, 0x52,
+	0x4c, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x20,
+	0x61, 0x6e, 0x20, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x20, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x20, 0x50, 0x55,
+	0x54, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x20, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x2c, 0x20, 0x74, 0x68, 0x65, 0x6e, 0x20, 0x50, 0x4f, 0x53, 0x54, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x74, 0x6f, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x2e, 0x20, 0x43, 0x61, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c,
+	0x12, 0xeb, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52, 0x4c, 0x22, 0xae, 0x01,
+	0x92, 0x41, 0x86, 0x01, 0x0a, 0x06, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x12, 0x19, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x20, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x20, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x1a, 0x4f, 0x54, 0x69, 0x6d, 0x65, 0x2d, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x55, 0x52, 0x4c,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x20, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x20, 0x43, 0x61, 0x72, 0x20, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x88,
+	0x02, 0x0a, 0x10, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8,
+	0x01, 0x92, 0x41, 0x8b, 0x01, 0x0a, 0x06, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x12, 0x12, 0x52,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x1a, 0x5b, 0x53, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x61, 0x72, 0x20, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x70, 0x69, 0x63, 0x6b, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x20, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x20,
+	0x43, 0x61, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10,
+	0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x41, 0x64, 0x64,
+	0x43, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x65, 0x43, 0x61, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x95, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xcb, 0x01, 0x92, 0x41, 0xa8, 0x01, 0x0a, 0x05, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x12,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x43, 0x61, 0x72, 0x20, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x1a,
+	0x8e, 0x01, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x2c, 0x20, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x61, 0x72, 0x2e, 0x20, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x20, 0x55, 0x52, 0x4c, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x61, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12,
+	0xbf, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x12, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x8e, 0x01, 0x92, 0x41, 0x75, 0x0a, 0x05, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x12, 0x10, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x61, 0x72, 0x20, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x1a,
+	0x48, 0x4d, 0x6f, 0x76, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2e, 0x20, 0x43, 0x61, 0x72,
+	0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x2a, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0xe7, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xa8, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54,
+	0x53, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x20, 0x69,
+	0x73, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x20, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x20, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x20, 0x69, 0x73, 0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79,
+	0x20, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x44, 0x45, 0x50,
+	0x54, 0x48, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xcb, 0x02, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72,
+	0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfd, 0x01, 0x92, 0x41, 0xdc, 0x01,
+	0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x1a,
+	0xba, 0x01, 0x41, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x6f, 0x70, 0x2d,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x69,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2c, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x69, 0x74, 0x73, 0x20, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x65, 0x73, 0x20, 0x61, 0x73, 0x20, 0x61, 0x20, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x20, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x20, 0x50, 0x61, 0x73, 0x73, 0x20, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x61, 0x73,
+	0x20, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x67, 0x65, 0x74, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5b, 0x92, 0x41, 0x3c, 0x0a, 0x08, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a,
+	0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x58, 0x92, 0x41, 0x3c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d,
+	0x45, 0x4e, 0x54, 0x53, 0x12, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d,
 	0x6d, 0x65, 0x6e, 0x74, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22,
-	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x93, 0x01,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43,
-	0x61, 0x72, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64,
-	0x1a, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x92,
-	0x41, 0x34, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x13, 0x47, 0x65,
-	0x74, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61,
-	0x72, 0x1a, 0x13, 0x47, 0x65, 0x74, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20,
-	0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x97, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x5b, 0x92, 0x41, 0x3c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12,
-	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
-	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x62,
-	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12,
-	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x1a, 0x11, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x99, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x42, 0x79, 0x43, 0x61, 0x72, 0x49, 0x64, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x43, 0x61, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x64, 0x92, 0x41, 0x40, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d,
+	0x45, 0x4e, 0x54, 0x53, 0x12, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49, 0x64, 0x1a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x2a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63,
+	0x61, 0x72, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x02, 0x0a, 0x0e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x54, 0x6f, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xcc, 0x01, 0x92, 0x41, 0xa2, 0x01, 0x0a, 0x08, 0x43, 0x4f,
+	0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x10, 0x52, 0x65, 0x61, 0x63, 0x74, 0x20, 0x54, 0x6f,
+	0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x72, 0x53, 0x65, 0x74, 0x20, 0x79, 0x6f,
+	0x75, 0x72, 0x20, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x28, 0x6c, 0x69, 0x6b,
+	0x65, 0x2c, 0x20, 0x68, 0x65, 0x6c, 0x70, 0x66, 0x75, 0x6c, 0x29, 0x20, 0x6f, 0x6e, 0x20, 0x61,
+	0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x20, 0x4f, 0x6e, 0x65, 0x20, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
+	0x70, 0x65, 0x72, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x61, 0x20, 0x6e,
+	0x65, 0x77, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x62, 0x10, 0x0a, 0x0e,
+	0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x1a, 0x17, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x80, 0x01, 0x92, 0x41, 0x5a, 0x0a,
+	0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x23, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x79, 0x6f, 0x75, 0x72, 0x20,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x61, 0x20,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x94, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
 	0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
 	0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x58, 0x92, 0x41, 0x3c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x10,
+	0x62, 0x92, 0x41, 0x3e, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x0f,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x1a, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x17, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x96, 0x02, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x22, 0xda, 0x01, 0x92, 0x41, 0xc0, 0x01, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x49,
+	0x45, 0x57, 0x53, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x1a, 0x93, 0x01, 0x52, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x63, 0x61, 0x72, 0x20,
+	0x6f, 0x72, 0x20, 0x61, 0x20, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d,
+	0x20, 0x31, 0x20, 0x74, 0x6f, 0x20, 0x35, 0x20, 0x73, 0x74, 0x61, 0x72, 0x73, 0x2e, 0x20, 0x4f,
+	0x6e, 0x65, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x70, 0x65, 0x72, 0x20, 0x75, 0x73,
+	0x65, 0x72, 0x20, 0x70, 0x65, 0x72, 0x20, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2c, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x20, 0x77, 0x65, 0x72, 0x65, 0x20, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x20, 0x62, 0x6f, 0x74, 0x68, 0x20, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0xad, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x72, 0x92, 0x41,
+	0x54, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x12, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x20, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x28, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x72, 0x20,
+	0x74, 0x65, 0x78, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x79, 0x6f, 0x75, 0x72, 0x20, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x1a, 0x10,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0xa2, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x0f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x73, 0x92, 0x41, 0x58, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x12, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x1a, 0x2c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x20,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x20, 0x6f, 0x72,
+	0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a,
+	0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc2, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41,
+	0x66, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x53, 0x12, 0x0c, 0x4c, 0x69, 0x73, 0x74,
+	0x20, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x1a, 0x4d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x63, 0x61, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x20,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x2c, 0x20, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x20, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x2c, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0xd2, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x22, 0x8a, 0x01, 0x92, 0x41, 0x60, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x53, 0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x41, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0xac, 0x02, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xdc, 0x01, 0x92, 0x41, 0xad, 0x01, 0x0a, 0x05, 0x41, 0x55, 0x44, 0x49, 0x54, 0x12, 0x0f, 0x47,
+	0x65, 0x74, 0x20, 0x41, 0x75, 0x64, 0x69, 0x74, 0x20, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x1a, 0x80,
+	0x01, 0x47, 0x65, 0x74, 0x20, 0x61, 0x75, 0x64, 0x69, 0x74, 0x20, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x28, 0x63,
+	0x61, 0x72, 0x2c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2c, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x2c, 0x20, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x29, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x6e, 0x6c,
+	0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xf2,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x93, 0x01,
+	0x92, 0x41, 0x74, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x51, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x3d, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x20, 0x63, 0x61, 0x72, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x84, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x92, 0x41, 0x91, 0x01,
+	0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x20, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x1a, 0x5d, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x20,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x6e, 0x65, 0x61, 0x72, 0x2d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x20, 0x74,
+	0x6f, 0x20, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x27, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0xdd, 0x01, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x93, 0x01, 0x92, 0x41, 0x6a, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x20,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x49, 0x74, 0x65, 0x6d, 0x1a,
+	0x31, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x20, 0x61, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x20, 0x69, 0x74, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e,
+	0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0xd8, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x8f, 0x01, 0x92, 0x41, 0x67, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x12, 0x16, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x2f, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x61, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20,
+	0x69, 0x74, 0x65, 0x6d, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10,
 	0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x43, 0x61,
-	0x72, 0x49, 0x64, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x49,
-	0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x64, 0x92, 0x41, 0x40, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x12, 0x19,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20,
-	0x42, 0x79, 0x20, 0x43, 0x61, 0x72, 0x20, 0x49, 0x64, 0x1a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x20, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x42, 0x79, 0x20, 0x43, 0x61,
-	0x72, 0x20, 0x49, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x61, 0x72, 0x2f, 0x7b, 0x63, 0x61,
-	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x42, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x17,
-	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x42, 0xc5, 0x01, 0x92, 0x41, 0xa9, 0x01,
-	0x12, 0x81, 0x01, 0x0a, 0x10, 0x43, 0x52, 0x55, 0x44, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x20, 0x41, 0x50, 0x49, 0x12, 0x17, 0x41, 0x50, 0x49, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75,
-	0x73, 0x65, 0x72, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4f,
-	0x0a, 0x0c, 0x43, 0x52, 0x55, 0x44, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27,
-	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x16, 0x79, 0x6f, 0x75, 0x72, 0x2d, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x32,
-	0x03, 0x31, 0x2e, 0x30, 0x5a, 0x23, 0x0a, 0x21, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x08, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x16, 0x77, 0x65, 0x67, 0x75, 0x67,
-	0x69, 0x6e, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x72, 0x75, 0x64,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xec, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x9f, 0x01, 0x92, 0x41, 0x6e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x12, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x1a, 0x32, 0x41, 0x73, 0x6b, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20,
+	0x74, 0x6f, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x61, 0x20, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
+	0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22,
+	0x23, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0xc4, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x86, 0x02, 0x92, 0x41, 0xec, 0x01, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4f, 0x52,
+	0x54, 0x53, 0x12, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x1a, 0xbe, 0x01, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x61, 0x20, 0x63, 0x61,
+	0x72, 0x2c, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x20, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x73, 0x70, 0x61, 0x6d, 0x2c, 0x20, 0x73, 0x63, 0x61,
+	0x6d, 0x2c, 0x20, 0x61, 0x62, 0x75, 0x73, 0x65, 0x2c, 0x20, 0x69, 0x6e, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x70, 0x72, 0x69, 0x61, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x20,
+	0x41, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20,
+	0x6f, 0x6e, 0x65, 0x20, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x70, 0x65, 0x72, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x3b, 0x20,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x63, 0x61,
+	0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x61, 0x67,
+	0x61, 0x69, 0x6e, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0xc2, 0x01, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x66, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x53,
+	0x12, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x3b,
+	0x4c, 0x69, 0x73, 0x74, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x20, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a,
+	0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0xbd, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x82, 0x01, 0x92, 0x41,
+	0x5d, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x12, 0x0d, 0x54, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x20, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x31, 0x4d, 0x61, 0x72, 0x6b, 0x20,
+	0x61, 0x6e, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x61,
+	0x73, 0x20, 0x62, 0x65, 0x69, 0x6e, 0x67, 0x20, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e,
+	0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x12, 0xda, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x9d, 0x01,
+	0x92, 0x41, 0x77, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x12, 0x0e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x20, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x4a, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x3a, 0x20,
+	0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x2e, 0x20, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x9d, 0x02,
+	0x0a, 0x10, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0xd2, 0x01, 0x92, 0x41, 0xaf, 0x01, 0x0a,
+	0x04, 0x43, 0x41, 0x52, 0x53, 0x12, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x20,
+	0x43, 0x61, 0x72, 0x20, 0x50, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x92, 0x01, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x20, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x6f, 0x6c, 0x64, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x28, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2c, 0x20, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x20,
+	0x79, 0x65, 0x61, 0x72, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x69, 0x6c, 0x65, 0x61, 0x67, 0x65,
+	0x2c, 0x20, 0x73, 0x61, 0x6d, 0x65, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x29, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0xd9, 0x01,
+	0x0a, 0x12, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x91, 0x01, 0x92, 0x41, 0x78, 0x0a, 0x07, 0x43, 0x41, 0x54,
+	0x41, 0x4c, 0x4f, 0x47, 0x12, 0x14, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x20, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x20, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x45, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6d,
+	0x61, 0x6b, 0x65, 0x2c, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x65, 0x73, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c,
+	0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x1a, 0x0b, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0xe3, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x9c, 0x01, 0x92, 0x41, 0x7a, 0x0a, 0x07, 0x43, 0x41, 0x54, 0x41, 0x4c, 0x4f, 0x47, 0x12,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x20,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x1a, 0x47, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20,
+	0x6d, 0x61, 0x6b, 0x65, 0x2c, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x20, 0x6f, 0x72, 0x20, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x65, 0x6c, 0x6f, 0x77, 0x20,
+	0x69, 0x74, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10,
+	0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x2f, 0x7b, 0x6b, 0x69, 0x6e, 0x64, 0x7d, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0xe8, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x23, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61,
+	0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x61, 0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x92, 0x41, 0x60, 0x0a, 0x07, 0x43, 0x41, 0x54, 0x41,
+	0x4c, 0x4f, 0x47, 0x12, 0x17, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x20, 0x4d, 0x61, 0x6b, 0x65, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x3c, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x20, 0x6d, 0x61,
+	0x6b, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x20, 0x62,
+	0x79, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x2c, 0x20, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65,
+	0x73, 0x20, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xd4, 0x01, 0x0a, 0x0d, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x92, 0x41, 0x6a, 0x0a, 0x06, 0x53,
+	0x45, 0x41, 0x52, 0x43, 0x48, 0x12, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x20, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x50, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x61, 0x73,
+	0x2d, 0x79, 0x6f, 0x75, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x20, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x73,
+	0x2c, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2c, 0x20, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x20,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0xcd, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92,
+	0x41, 0x54, 0x0a, 0x06, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x12, 0x15, 0x47, 0x65, 0x74, 0x20,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x73, 0x1a, 0x33, 0x4d, 0x6f, 0x73, 0x74, 0x20, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x74,
+	0x20, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x20,
+	0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0xc9, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x22, 0xfa, 0x01, 0x92, 0x41, 0xd0, 0x01, 0x0a, 0x09, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45,
+	0x52, 0x53, 0x12, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x20, 0x43, 0x61, 0x72,
+	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a, 0x99, 0x01, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f,
+	0x20, 0x61, 0x6e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x20, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x20, 0x54,
+	0x68, 0x65, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x61, 0x79, 0x73,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x73, 0x20, 0x61, 0x20, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a,
+	0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0xd3, 0x01,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7e, 0x92, 0x41, 0x66, 0x0a, 0x09, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46,
+	0x45, 0x52, 0x53, 0x12, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x43, 0x61, 0x72, 0x20, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x1a, 0x33, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x62, 0x10, 0x0a, 0x0e,
+	0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0xd3, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x43, 0x61,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x1a,
+	0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x99, 0x01,
+	0x92, 0x41, 0x72, 0x0a, 0x09, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x53, 0x12, 0x13,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x20, 0x43, 0x61, 0x72, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x1a, 0x3e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x20, 0x61, 0x20, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x62, 0x65, 0x63, 0x6f, 0x6d, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x2e, 0x20, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x20, 0x6f,
+	0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0xc2, 0x01, 0x0a, 0x12, 0x44, 0x65,
+	0x63, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x87, 0x01, 0x92, 0x41, 0x5f, 0x0a, 0x09, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x45, 0x52, 0x53, 0x12, 0x14, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x43,
+	0x61, 0x72, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a, 0x2a, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x20, 0x61, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x20, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a,
+	0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xe7,
+	0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x72,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xad, 0x01, 0x92, 0x41, 0x85, 0x01, 0x0a,
+	0x09, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x53, 0x12, 0x13, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x20, 0x43, 0x61, 0x72, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a,
+	0x51, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x20, 0x61, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x20, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x6f, 0x72,
+	0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0xe0, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x92, 0x41, 0x73, 0x0a, 0x0d, 0x4f, 0x52,
+	0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x12, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x3b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x64, 0x65, 0x61, 0x6c, 0x65,
+	0x72, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x20,
+	0x54, 0x68, 0x65, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x62, 0x65, 0x63, 0x6f,
+	0x6d, 0x65, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x62, 0x10, 0x0a,
+	0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x20, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x92, 0x41, 0x67, 0x0a, 0x0d, 0x4f, 0x52, 0x47, 0x41, 0x4e,
+	0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x12, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x4d,
+	0x79, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x2d, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
+	0x69, 0x73, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x62, 0x10,
+	0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e,
+	0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x92, 0x41, 0x64, 0x0a,
+	0x0d, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x12, 0x10,
+	0x47, 0x65, 0x74, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x2f, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77,
+	0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x2e,
+	0x20, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74,
+	0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x12, 0xf3, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa6,
+	0x01, 0x92, 0x41, 0x76, 0x0a, 0x0d, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x12, 0x17, 0x41, 0x64, 0x64, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x3a, 0x41, 0x64,
+	0x64, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x72, 0x6f, 0x6c, 0x65, 0x2e,
+	0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x94, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63,
+	0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xc1, 0x01, 0x92, 0x41, 0x89,
+	0x01, 0x0a, 0x0d, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53,
+	0x12, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x4a, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x20, 0x61, 0x20, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x20, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74,
+	0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
+	0x2a, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa9,
+	0x02, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x61, 0x72, 0x54, 0x6f, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x75,
+	0x64, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x61, 0x72, 0x54, 0x6f, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0xd8, 0x01, 0x92, 0x41, 0xab, 0x01, 0x0a, 0x0d, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x53, 0x12, 0x1a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x20, 0x43, 0x61,
+	0x72, 0x20, 0x54, 0x6f, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x20, 0x69, 0x74, 0x2e, 0x20, 0x43, 0x61, 0x72, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x20, 0x77, 0x68, 0x6f, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x8f, 0x02, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x92, 0x41, 0x77, 0x0a, 0x0d, 0x4f, 0x52, 0x47, 0x41,
+	0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x12, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x20,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x38, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x20,
+	0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x27, 0x73, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x20, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x62,
+	0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x98, 0x01, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x0c, 0x2e, 0x63, 0x72,
+	0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64,
+	0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x6e, 0x92, 0x41, 0x5a, 0x0a, 0x05, 0x51, 0x55,
+	0x4f, 0x54, 0x41, 0x12, 0x0c, 0x47, 0x65, 0x74, 0x20, 0x4d, 0x79, 0x20, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x1a, 0x31, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x6c, 0x61, 0x6e, 0x20,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76,
+	0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0xab, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x19, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e,
+	0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x63, 0x72, 0x75, 0x64, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x73, 0x92, 0x41, 0x4d, 0x0a, 0x05, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x12, 0x0d, 0x53, 0x65,
+	0x74, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x50, 0x6c, 0x61, 0x6e, 0x1a, 0x23, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x20, 0x61, 0x20, 0x70, 0x6c, 0x61, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x6f, 0x6e, 0x6c, 0x79,
+	0x62, 0x10, 0x0a, 0x0e, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x76, 0x31,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x42, 0xc5, 0x01, 0x92, 0x41, 0xa9, 0x01, 0x12, 0x81, 0x01, 0x0a,
+	0x10, 0x43, 0x52, 0x55, 0x44, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x50,
+	0x49, 0x12, 0x17, 0x41, 0x50, 0x49, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x0c, 0x43, 0x52,
+	0x55, 0x44, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x27, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79,
+	0x6f, 0x75, 0x72, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x1a, 0x16, 0x79, 0x6f, 0x75, 0x72, 0x2d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x40,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x32, 0x03, 0x31, 0x2e, 0x30,
+	0x5a, 0x23, 0x0a, 0x21, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x13, 0x08, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x02, 0x5a, 0x16, 0x77, 0x65, 0x67, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x67,
+	0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x72, 0x75, 0x64, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_cruds_cruds_proto_goTypes = []any{
@@ -365,118 +1042,274 @@ var file_cruds_cruds_proto_goTypes = []any{
 	(*GetUnreadNotificationsRequest)(nil),        // 12: cruds.GetUnreadNotificationsRequest
 	(*MarkNotificationAsReadRequest)(nil),        // 13: cruds.MarkNotificationAsReadRequest
 	(*DeleteNotificationRequest)(nil),            // 14: cruds.DeleteNotificationRequest
-	(*SendMessageRequest)(nil),                   // 15: cruds.SendMessageRequest
-	(*GetMessagesByUserRequest)(nil),             // 16: cruds.GetMessagesByUserRequest
-	(*MessageId)(nil),                            // 17: cruds.MessageId
-	(*DeleteMessageRequest)(nil),                 // 18: cruds.DeleteMessageRequest
-	(*BoolCheckMessage)(nil),                     // 19: cruds.BoolCheckMessage
-	(*GetMessageByUserAndIdReq)(nil),             // 20: cruds.GetMessageByUserAndIdReq
-	(*RegisterNotificationTokenRequest)(nil),     // 21: cruds.RegisterNotificationTokenRequest
-	(*GetNotificationTokensByUserIdRequest)(nil), // 22: cruds.GetNotificationTokensByUserIdRequest
-	(*DeleteNotificationTokenRequest)(nil),       // 23: cruds.DeleteNotificationTokenRequest
-	(*AddImageRequest)(nil),                      // 24: cruds.AddImageRequest
-	(*ImageId)(nil),                              // 25: cruds.ImageId
-	(*CreateCommentRequest)(nil),                 // 26: cruds.CreateCommentRequest
-	(*UpdateCommentRequest)(nil),                 // 27: cruds.UpdateCommentRequest
-	(*CommentId)(nil),                            // 28: cruds.CommentId
-	(*BoolCheckComment)(nil),                     // 29: cruds.BoolCheckComment
-	(*Car)(nil),                                  // 30: cruds.Car
-	(*ListCarsResponse)(nil),                     // 31: cruds.ListCarsResponse
-	(*Empty)(nil),                                // 32: cruds.Empty
-	(*BoolCheck)(nil),                            // 33: cruds.BoolCheck
-	(*ListSavedCarsResponse)(nil),                // 34: cruds.ListSavedCarsResponse
-	(*ListNotificationsResponse)(nil),            // 35: cruds.ListNotificationsResponse
-	(*Message)(nil),                              // 36: cruds.Message
-	(*ListMessagesResponse)(nil),                 // 37: cruds.ListMessagesResponse
-	(*GetMessageByUserAndIdRes)(nil),             // 38: cruds.GetMessageByUserAndIdRes
-	(*ListNotificationTokensResponse)(nil),       // 39: cruds.ListNotificationTokensResponse
-	(*Image)(nil),                                // 40: cruds.Image
-	(*ListImagesResponse)(nil),                   // 41: cruds.ListImagesResponse
-	(*Comment)(nil),                              // 42: cruds.Comment
-	(*ListCommentsResponse)(nil),                 // 43: cruds.ListCommentsResponse
+	(*RestoreNotificationRequest)(nil),           // 15: cruds.RestoreNotificationRequest
+	(*SendMessageRequest)(nil),                   // 16: cruds.SendMessageRequest
+	(*GetMessagesByUserRequest)(nil),             // 17: cruds.GetMessagesByUserRequest
+	(*MessageId)(nil),                            // 18: cruds.MessageId
+	(*DeleteMessageRequest)(nil),                 // 19: cruds.DeleteMessageRequest
+	(*BoolCheckMessage)(nil),                     // 20: cruds.BoolCheckMessage
+	(*GetMessageByUserAndIdReq)(nil),             // 21: cruds.GetMessageByUserAndIdReq
+	(*RegisterNotificationTokenRequest)(nil),     // 22: cruds.RegisterNotificationTokenRequest
+	(*GetNotificationTokensByUserIdRequest)(nil), // 23: cruds.GetNotificationTokensByUserIdRequest
+	(*DeleteNotificationTokenRequest)(nil),       // 24: cruds.DeleteNotificationTokenRequest
+	(*AddImageRequest)(nil),                      // 25: cruds.AddImageRequest
+	(*ImageId)(nil),                              // 26: cruds.ImageId
+	(*CreateImageUploadURLRequest)(nil),          // 27: cruds.CreateImageUploadURLRequest
+	(*ReorderCarImagesRequest)(nil),              // 28: cruds.ReorderCarImagesRequest
+	(*AddCarMediaRequest)(nil),                   // 29: cruds.AddCarMediaRequest
+	(*ListCarMediaRequest)(nil),                  // 30: cruds.ListCarMediaRequest
+	(*MediaId)(nil),                              // 31: cruds.MediaId
+	(*CreateCommentRequest)(nil),                 // 32: cruds.CreateCommentRequest
+	(*ListCommentsRequest)(nil),                  // 33: cruds.ListCommentsRequest
+	(*UpdateCommentRequest)(nil),                 // 34: cruds.UpdateCommentRequest
+	(*CommentId)(nil),                            // 35: cruds.CommentId
+	(*ReactToCommentRequest)(nil),                // 36: cruds.ReactToCommentRequest
+	(*BoolCheckComment)(nil),                     // 37: cruds.BoolCheckComment
+	(*CreateReviewRequest)(nil),                  // 38: cruds.CreateReviewRequest
+	(*UpdateReviewRequest)(nil),                  // 39: cruds.UpdateReviewRequest
+	(*ReviewId)(nil),                             // 40: cruds.ReviewId
+	(*ListReviewsRequest)(nil),                   // 41: cruds.ListReviewsRequest
+	(*SellerSummaryRequest)(nil),                 // 42: cruds.SellerSummaryRequest
+	(*GetAuditTrailRequest)(nil),                 // 43: cruds.GetAuditTrailRequest
+	(*ListModerationQueueRequest)(nil),           // 44: cruds.ListModerationQueueRequest
+	(*ListImageMatchesRequest)(nil),              // 45: cruds.ListImageMatchesRequest
+	(*ModerationDecisionRequest)(nil),            // 46: cruds.ModerationDecisionRequest
+	(*ReportContentRequest)(nil),                 // 47: cruds.ReportContentRequest
+	(*ListReportsRequest)(nil),                   // 48: cruds.ListReportsRequest
+	(*TriageReportRequest)(nil),                  // 49: cruds.TriageReportRequest
+	(*ResolveReportRequest)(nil),                 // 50: cruds.ResolveReportRequest
+	(*EstimateCarPriceRequest)(nil),              // 51: cruds.EstimateCarPriceRequest
+	(*CatalogEntryRequest)(nil),                  // 52: cruds.CatalogEntryRequest
+	(*DeleteCatalogEntryRequest)(nil),            // 53: cruds.DeleteCatalogEntryRequest
+	(*AutocompleteMakeModelRequest)(nil),         // 54: cruds.AutocompleteMakeModelRequest
+	(*SuggestSearchRequest)(nil),                 // 55: cruds.SuggestSearchRequest
+	(*GetTrendingSearchesRequest)(nil),           // 56: cruds.GetTrendingSearchesRequest
+	(*InitiateCarTransferRequest)(nil),           // 57: cruds.InitiateCarTransferRequest
+	(*ListCarTransfersRequest)(nil),              // 58: cruds.ListCarTransfersRequest
+	(*CarTransferId)(nil),                        // 59: cruds.CarTransferId
+	(*CreateOrganizationRequest)(nil),            // 60: cruds.CreateOrganizationRequest
+	(*Empty)(nil),                                // 61: cruds.Empty
+	(*OrganizationId)(nil),                       // 62: cruds.OrganizationId
+	(*AddOrganizationMemberRequest)(nil),         // 63: cruds.AddOrganizationMemberRequest
+	(*RemoveOrganizationMemberRequest)(nil),      // 64: cruds.RemoveOrganizationMemberRequest
+	(*AssignCarToOrganizationRequest)(nil),       // 65: cruds.AssignCarToOrganizationRequest
+	(*ListOrganizationMessagesRequest)(nil),      // 66: cruds.ListOrganizationMessagesRequest
+	(*SetUserPlanRequest)(nil),                   // 67: cruds.SetUserPlanRequest
+	(*Car)(nil),                                  // 68: cruds.Car
+	(*ListCarsResponse)(nil),                     // 69: cruds.ListCarsResponse
+	(*BoolCheck)(nil),                            // 70: cruds.BoolCheck
+	(*ListSavedCarsResponse)(nil),                // 71: cruds.ListSavedCarsResponse
+	(*ListNotificationsResponse)(nil),            // 72: cruds.ListNotificationsResponse
+	(*Message)(nil),                              // 73: cruds.Message
+	(*ListMessagesResponse)(nil),                 // 74: cruds.ListMessagesResponse
+	(*GetMessageByUserAndIdRes)(nil),             // 75: cruds.GetMessageByUserAndIdRes
+	(*ListNotificationTokensResponse)(nil),       // 76: cruds.ListNotificationTokensResponse
+	(*Image)(nil),                                // 77: cruds.Image
+	(*ListImagesResponse)(nil),                   // 78: cruds.ListImagesResponse
+	(*SignedURL)(nil),                            // 79: cruds.SignedURL
+	(*MediaItem)(nil),                            // 80: cruds.MediaItem
+	(*ListCarMediaResponse)(nil),                 // 81: cruds.ListCarMediaResponse
+	(*Comment)(nil),                              // 82: cruds.Comment
+	(*ListCommentsResponse)(nil),                 // 83: cruds.ListCommentsResponse
+	(*CommentReactions)(nil),                     // 84: cruds.CommentReactions
+	(*Review)(nil),                               // 85: cruds.Review
+	(*ListReviewsResponse)(nil),                  // 86: cruds.ListReviewsResponse
+	(*SellerSummary)(nil),                        // 87: cruds.SellerSummary
+	(*ListAuditEntriesResponse)(nil),             // 88: cruds.ListAuditEntriesResponse
+	(*ListModerationItemsResponse)(nil),          // 89: cruds.ListModerationItemsResponse
+	(*ListImageMatchesResponse)(nil),             // 90: cruds.ListImageMatchesResponse
+	(*Report)(nil),                               // 91: cruds.Report
+	(*ListReportsResponse)(nil),                  // 92: cruds.ListReportsResponse
+	(*PriceEstimate)(nil),                        // 93: cruds.PriceEstimate
+	(*CatalogEntry)(nil),                         // 94: cruds.CatalogEntry
+	(*AutocompleteMakeModelResponse)(nil),        // 95: cruds.AutocompleteMakeModelResponse
+	(*SuggestSearchResponse)(nil),                // 96: cruds.SuggestSearchResponse
+	(*TrendingSearchesResponse)(nil),             // 97: cruds.TrendingSearchesResponse
+	(*CarTransfer)(nil),                          // 98: cruds.CarTransfer
+	(*ListCarTransfersResponse)(nil),             // 99: cruds.ListCarTransfersResponse
+	(*Organization)(nil),                         // 100: cruds.Organization
+	(*ListOrganizationsResponse)(nil),            // 101: cruds.ListOrganizationsResponse
+	(*OrganizationMessagesResponse)(nil),         // 102: cruds.OrganizationMessagesResponse
+	(*Quota)(nil),                                // 103: cruds.Quota
 }
 var file_cruds_cruds_proto_depIdxs = []int32{
-	0,  // 0: cruds.CrudsService.CreateCar:input_type -> cruds.CreateCarRequest
-	1,  // 1: cruds.CrudsService.GetCarById:input_type -> cruds.Id
-	2,  // 2: cruds.CrudsService.ListCars:input_type -> cruds.ListCarsRequest
-	3,  // 3: cruds.CrudsService.UpdateCar:input_type -> cruds.UpdateCarRequest
-	1,  // 4: cruds.CrudsService.DeleteCar:input_type -> cruds.Id
-	1,  // 5: cruds.CrudsService.IncrementCarReviewCount:input_type -> cruds.Id
-	4,  // 6: cruds.CrudsService.SearchCar:input_type -> cruds.SearchCarRequest
-	5,  // 7: cruds.CrudsService.CheckCarOwnership:input_type -> cruds.BoolCheckCar
-	6,  // 8: cruds.CrudsService.SaveCar:input_type -> cruds.SaveCarRequest
-	7,  // 9: cruds.CrudsService.GetSavedCarsByUser:input_type -> cruds.GetSavedCarsRequest
-	8,  // 10: cruds.CrudsService.DeleteSavedCar:input_type -> cruds.DeleteSavedCarRequest
-	9,  // 11: cruds.CrudsService.DeleteSavedCarsByCarId:input_type -> cruds.CarId
-	10, // 12: cruds.CrudsService.CheckSavedCarOwnership:input_type -> cruds.BoolCheckSavedCars
-	11, // 13: cruds.CrudsService.CreateNotification:input_type -> cruds.CreateNotificationRequest
-	12, // 14: cruds.CrudsService.GetAllNotificationsByUserId:input_type -> cruds.GetUnreadNotificationsRequest
-	12, // 15: cruds.CrudsService.GetUnreadNotifications:input_type -> cruds.GetUnreadNotificationsRequest
-	13, // 16: cruds.CrudsService.MarkNotificationAsRead:input_type -> cruds.MarkNotificationAsReadRequest
-	14, // 17: cruds.CrudsService.DeleteNotification:input_type -> cruds.DeleteNotificationRequest
-	15, // 18: cruds.CrudsService.SendMessage:input_type -> cruds.SendMessageRequest
-	16, // 19: cruds.CrudsService.GetMessagesByUser:input_type -> cruds.GetMessagesByUserRequest
-	17, // 20: cruds.CrudsService.MarkMessageAsRead:input_type -> cruds.MessageId
-	18, // 21: cruds.CrudsService.DeleteMessage:input_type -> cruds.DeleteMessageRequest
-	19, // 22: cruds.CrudsService.CheckMessageOwnership:input_type -> cruds.BoolCheckMessage
-	20, // 23: cruds.CrudsService.GetMessageByUserAndId:input_type -> cruds.GetMessageByUserAndIdReq
-	21, // 24: cruds.CrudsService.RegisterNotificationToken:input_type -> cruds.RegisterNotificationTokenRequest
-	22, // 25: cruds.CrudsService.GetNotificationTokensByUserId:input_type -> cruds.GetNotificationTokensByUserIdRequest
-	23, // 26: cruds.CrudsService.DeleteNotificationToken:input_type -> cruds.DeleteNotificationTokenRequest
-	24, // 27: cruds.CrudsService.AddImage:input_type -> cruds.AddImageRequest
-	9,  // 28: cruds.CrudsService.GetImagesByCar:input_type -> cruds.CarId
-	25, // 29: cruds.CrudsService.DeleteImage:input_type -> cruds.ImageId
-	9,  // 30: cruds.CrudsService.DeleteImagesByCarId:input_type -> cruds.CarId
-	25, // 31: cruds.CrudsService.GetImageByID:input_type -> cruds.ImageId
-	26, // 32: cruds.CrudsService.CreateComment:input_type -> cruds.CreateCommentRequest
-	9,  // 33: cruds.CrudsService.GetCommentsByCar:input_type -> cruds.CarId
-	27, // 34: cruds.CrudsService.UpdateComment:input_type -> cruds.UpdateCommentRequest
-	28, // 35: cruds.CrudsService.DeleteComment:input_type -> cruds.CommentId
-	9,  // 36: cruds.CrudsService.DeleteCommentsByCarId:input_type -> cruds.CarId
-	29, // 37: cruds.CrudsService.CheckCommentOwnership:input_type -> cruds.BoolCheckComment
-	30, // 38: cruds.CrudsService.CreateCar:output_type -> cruds.Car
-	30, // 39: cruds.CrudsService.GetCarById:output_type -> cruds.Car
-	31, // 40: cruds.CrudsService.ListCars:output_type -> cruds.ListCarsResponse
-	32, // 41: cruds.CrudsService.UpdateCar:output_type -> cruds.Empty
-	32, // 42: cruds.CrudsService.DeleteCar:output_type -> cruds.Empty
-	32, // 43: cruds.CrudsService.IncrementCarReviewCount:output_type -> cruds.Empty
-	31, // 44: cruds.CrudsService.SearchCar:output_type -> cruds.ListCarsResponse
-	33, // 45: cruds.CrudsService.CheckCarOwnership:output_type -> cruds.BoolCheck
-	32, // 46: cruds.CrudsService.SaveCar:output_type -> cruds.Empty
-	34, // 47: cruds.CrudsService.GetSavedCarsByUser:output_type -> cruds.ListSavedCarsResponse
-	32, // 48: cruds.CrudsService.DeleteSavedCar:output_type -> cruds.Empty
-	32, // 49: cruds.CrudsService.DeleteSavedCarsByCarId:output_type -> cruds.Empty
-	33, // 50: cruds.CrudsService.CheckSavedCarOwnership:output_type -> cruds.BoolCheck
-	32, // 51: cruds.CrudsService.CreateNotification:output_type -> cruds.Empty
-	35, // 52: cruds.CrudsService.GetAllNotificationsByUserId:output_type -> cruds.ListNotificationsResponse
-	35, // 53: cruds.CrudsService.GetUnreadNotifications:output_type -> cruds.ListNotificationsResponse
-	32, // 54: cruds.CrudsService.MarkNotificationAsRead:output_type -> cruds.Empty
-	32, // 55: cruds.CrudsService.DeleteNotification:output_type -> cruds.Empty
-	36, // 56: cruds.CrudsService.SendMessage:output_type -> cruds.Message
-	37, // 57: cruds.CrudsService.GetMessagesByUser:output_type -> cruds.ListMessagesResponse
-	32, // 58: cruds.CrudsService.MarkMessageAsRead:output_type -> cruds.Empty
-	32, // 59: cruds.CrudsService.DeleteMessage:output_type -> cruds.Empty
-	33, // 60: cruds.CrudsService.CheckMessageOwnership:output_type -> cruds.BoolCheck
-	38, // 61: cruds.CrudsService.GetMessageByUserAndId:output_type -> cruds.GetMessageByUserAndIdRes
-	32, // 62: cruds.CrudsService.RegisterNotificationToken:output_type -> cruds.Empty
-	39, // 63: cruds.CrudsService.GetNotificationTokensByUserId:output_type -> cruds.ListNotificationTokensResponse
-	32, // 64: cruds.CrudsService.DeleteNotificationToken:output_type -> cruds.Empty
-	40, // 65: cruds.CrudsService.AddImage:output_type -> cruds.Image
-	41, // 66: cruds.CrudsService.GetImagesByCar:output_type -> cruds.ListImagesResponse
-	32, // 67: cruds.CrudsService.DeleteImage:output_type -> cruds.Empty
-	32, // 68: cruds.CrudsService.DeleteImagesByCarId:output_type -> cruds.Empty
-	40, // 69: cruds.CrudsService.GetImageByID:output_type -> cruds.Image
-	42, // 70: cruds.CrudsService.CreateComment:output_type -> cruds.Comment
-	43, // 71: cruds.CrudsService.GetCommentsByCar:output_type -> cruds.ListCommentsResponse
-	32, // 72: cruds.CrudsService.UpdateComment:output_type -> cruds.Empty
-	32, // 73: cruds.CrudsService.DeleteComment:output_type -> cruds.Empty
-	32, // 74: cruds.CrudsService.DeleteCommentsByCarId:output_type -> cruds.Empty
-	33, // 75: cruds.CrudsService.CheckCommentOwnership:output_type -> cruds.BoolCheck
-	38, // [38:76] is the sub-list for method output_type
-	0,  // [0:38] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: cruds.CrudsService.CreateCar:input_type -> cruds.CreateCarRequest
+	1,   // 1: cruds.CrudsService.GetCarById:input_type -> cruds.Id
+	2,   // 2: cruds.CrudsService.ListCars:input_type -> cruds.ListCarsRequest
+	3,   // 3: cruds.CrudsService.UpdateCar:input_type -> cruds.UpdateCarRequest
+	1,   // 4: cruds.CrudsService.DeleteCar:input_type -> cruds.Id
+	1,   // 5: cruds.CrudsService.RestoreCar:input_type -> cruds.Id
+	1,   // 6: cruds.CrudsService.IncrementCarReviewCount:input_type -> cruds.Id
+	4,   // 7: cruds.CrudsService.SearchCar:input_type -> cruds.SearchCarRequest
+	5,   // 8: cruds.CrudsService.CheckCarOwnership:input_type -> cruds.BoolCheckCar
+	6,   // 9: cruds.CrudsService.SaveCar:input_type -> cruds.SaveCarRequest
+	7,   // 10: cruds.CrudsService.GetSavedCarsByUser:input_type -> cruds.GetSavedCarsRequest
+	8,   // 11: cruds.CrudsService.DeleteSavedCar:input_type -> cruds.DeleteSavedCarRequest
+	9,   // 12: cruds.CrudsService.DeleteSavedCarsByCarId:input_type -> cruds.CarId
+	10,  // 13: cruds.CrudsService.CheckSavedCarOwnership:input_type -> cruds.BoolCheckSavedCars
+	11,  // 14: cruds.CrudsService.CreateNotification:input_type -> cruds.CreateNotificationRequest
+	12,  // 15: cruds.CrudsService.GetAllNotificationsByUserId:input_type -> cruds.GetUnreadNotificationsRequest
+	12,  // 16: cruds.CrudsService.GetUnreadNotifications:input_type -> cruds.GetUnreadNotificationsRequest
+	13,  // 17: cruds.CrudsService.MarkNotificationAsRead:input_type -> cruds.MarkNotificationAsReadRequest
+	14,  // 18: cruds.CrudsService.DeleteNotification:input_type -> cruds.DeleteNotificationRequest
+	15,  // 19: cruds.CrudsService.RestoreNotification:input_type -> cruds.RestoreNotificationRequest
+	16,  // 20: cruds.CrudsService.SendMessage:input_type -> cruds.SendMessageRequest
+	17,  // 21: cruds.CrudsService.GetMessagesByUser:input_type -> cruds.GetMessagesByUserRequest
+	18,  // 22: cruds.CrudsService.MarkMessageAsRead:input_type -> cruds.MessageId
+	19,  // 23: cruds.CrudsService.DeleteMessage:input_type -> cruds.DeleteMessageRequest
+	20,  // 24: cruds.CrudsService.CheckMessageOwnership:input_type -> cruds.BoolCheckMessage
+	21,  // 25: cruds.CrudsService.GetMessageByUserAndId:input_type -> cruds.GetMessageByUserAndIdReq
+	22,  // 26: cruds.CrudsService.RegisterNotificationToken:input_type -> cruds.RegisterNotificationTokenRequest
+	23,  // 27: cruds.CrudsService.GetNotificationTokensByUserId:input_type -> cruds.GetNotificationTokensByUserIdRequest
+	24,  // 28: cruds.CrudsService.DeleteNotificationToken:input_type -> cruds.DeleteNotificationTokenRequest
+	25,  // 29: cruds.CrudsService.AddImage:input_type -> cruds.AddImageRequest
+	9,   // 30: cruds.CrudsService.GetImagesByCar:input_type -> cruds.CarId
+	26,  // 31: cruds.CrudsService.DeleteImage:input_type -> cruds.ImageId
+	9,   // 32: cruds.CrudsService.DeleteImagesByCarId:input_type -> cruds.CarId
+	26,  // 33: cruds.CrudsService.RestoreImage:input_type -> cruds.ImageId
+	26,  // 34: cruds.CrudsService.GetImageByID:input_type -> cruds.ImageId
+	27,  // 35: cruds.CrudsService.CreateImageUploadURL:input_type -> cruds.CreateImageUploadURLRequest
+	26,  // 36: cruds.CrudsService.CreateImageDownloadURL:input_type -> cruds.ImageId
+	28,  // 37: cruds.CrudsService.ReorderCarImages:input_type -> cruds.ReorderCarImagesRequest
+	29,  // 38: cruds.CrudsService.AddCarMedia:input_type -> cruds.AddCarMediaRequest
+	9,   // 39: cruds.CrudsService.AuthorizeCarUpload:input_type -> cruds.CarId
+	30,  // 40: cruds.CrudsService.ListCarMedia:input_type -> cruds.ListCarMediaRequest
+	31,  // 41: cruds.CrudsService.DeleteCarMedia:input_type -> cruds.MediaId
+	32,  // 42: cruds.CrudsService.CreateComment:input_type -> cruds.CreateCommentRequest
+	33,  // 43: cruds.CrudsService.GetCommentsByCar:input_type -> cruds.ListCommentsRequest
+	34,  // 44: cruds.CrudsService.UpdateComment:input_type -> cruds.UpdateCommentRequest
+	35,  // 45: cruds.CrudsService.DeleteComment:input_type -> cruds.CommentId
+	9,   // 46: cruds.CrudsService.DeleteCommentsByCarId:input_type -> cruds.CarId
+	36,  // 47: cruds.CrudsService.ReactToComment:input_type -> cruds.ReactToCommentRequest
+	35,  // 48: cruds.CrudsService.RemoveCommentReaction:input_type -> cruds.CommentId
+	35,  // 49: cruds.CrudsService.RestoreComment:input_type -> cruds.CommentId
+	37,  // 50: cruds.CrudsService.CheckCommentOwnership:input_type -> cruds.BoolCheckComment
+	38,  // 51: cruds.CrudsService.CreateReview:input_type -> cruds.CreateReviewRequest
+	39,  // 52: cruds.CrudsService.UpdateReview:input_type -> cruds.UpdateReviewRequest
+	40,  // 53: cruds.CrudsService.DeleteReview:input_type -> cruds.ReviewId
+	41,  // 54: cruds.CrudsService.ListReviews:input_type -> cruds.ListReviewsRequest
+	42,  // 55: cruds.CrudsService.GetSellerSummary:input_type -> cruds.SellerSummaryRequest
+	43,  // 56: cruds.CrudsService.GetAuditTrail:input_type -> cruds.GetAuditTrailRequest
+	44,  // 57: cruds.CrudsService.ListModerationQueue:input_type -> cruds.ListModerationQueueRequest
+	45,  // 58: cruds.CrudsService.ListImageMatches:input_type -> cruds.ListImageMatchesRequest
+	46,  // 59: cruds.CrudsService.ApproveModerationItem:input_type -> cruds.ModerationDecisionRequest
+	46,  // 60: cruds.CrudsService.RejectModerationItem:input_type -> cruds.ModerationDecisionRequest
+	46,  // 61: cruds.CrudsService.RequestModerationChanges:input_type -> cruds.ModerationDecisionRequest
+	47,  // 62: cruds.CrudsService.ReportContent:input_type -> cruds.ReportContentRequest
+	48,  // 63: cruds.CrudsService.ListReports:input_type -> cruds.ListReportsRequest
+	49,  // 64: cruds.CrudsService.TriageReport:input_type -> cruds.TriageReportRequest
+	50,  // 65: cruds.CrudsService.ResolveReport:input_type -> cruds.ResolveReportRequest
+	51,  // 66: cruds.CrudsService.EstimateCarPrice:input_type -> cruds.EstimateCarPriceRequest
+	52,  // 67: cruds.CrudsService.UpsertCatalogEntry:input_type -> cruds.CatalogEntryRequest
+	53,  // 68: cruds.CrudsService.DeleteCatalogEntry:input_type -> cruds.DeleteCatalogEntryRequest
+	54,  // 69: cruds.CrudsService.AutocompleteMakeModel:input_type -> cruds.AutocompleteMakeModelRequest
+	55,  // 70: cruds.CrudsService.SuggestSearch:input_type -> cruds.SuggestSearchRequest
+	56,  // 71: cruds.CrudsService.GetTrendingSearches:input_type -> cruds.GetTrendingSearchesRequest
+	57,  // 72: cruds.CrudsService.InitiateCarTransfer:input_type -> cruds.InitiateCarTransferRequest
+	58,  // 73: cruds.CrudsService.ListCarTransfers:input_type -> cruds.ListCarTransfersRequest
+	59,  // 74: cruds.CrudsService.AcceptCarTransfer:input_type -> cruds.CarTransferId
+	59,  // 75: cruds.CrudsService.DeclineCarTransfer:input_type -> cruds.CarTransferId
+	59,  // 76: cruds.CrudsService.CancelCarTransfer:input_type -> cruds.CarTransferId
+	60,  // 77: cruds.CrudsService.CreateOrganization:input_type -> cruds.CreateOrganizationRequest
+	61,  // 78: cruds.CrudsService.ListMyOrganizations:input_type -> cruds.Empty
+	62,  // 79: cruds.CrudsService.GetOrganization:input_type -> cruds.OrganizationId
+	63,  // 80: cruds.CrudsService.AddOrganizationMember:input_type -> cruds.AddOrganizationMemberRequest
+	64,  // 81: cruds.CrudsService.RemoveOrganizationMember:input_type -> cruds.RemoveOrganizationMemberRequest
+	65,  // 82: cruds.CrudsService.AssignCarToOrganization:input_type -> cruds.AssignCarToOrganizationRequest
+	66,  // 83: cruds.CrudsService.ListOrganizationMessages:input_type -> cruds.ListOrganizationMessagesRequest
+	61,  // 84: cruds.CrudsService.GetMyQuota:input_type -> cruds.Empty
+	67,  // 85: cruds.CrudsService.SetUserPlan:input_type -> cruds.SetUserPlanRequest
+	68,  // 86: cruds.CrudsService.CreateCar:output_type -> cruds.Car
+	68,  // 87: cruds.CrudsService.GetCarById:output_type -> cruds.Car
+	69,  // 88: cruds.CrudsService.ListCars:output_type -> cruds.ListCarsResponse
+	61,  // 89: cruds.CrudsService.UpdateCar:output_type -> cruds.Empty
+	61,  // 90: cruds.CrudsService.DeleteCar:output_type -> cruds.Empty
+	61,  // 91: cruds.CrudsService.RestoreCar:output_type -> cruds.Empty
+	61,  // 92: cruds.CrudsService.IncrementCarReviewCount:output_type -> cruds.Empty
+	69,  // 93: cruds.CrudsService.SearchCar:output_type -> cruds.ListCarsResponse
+	70,  // 94: cruds.CrudsService.CheckCarOwnership:output_type -> cruds.BoolCheck
+	61,  // 95: cruds.CrudsService.SaveCar:output_type -> cruds.Empty
+	71,  // 96: cruds.CrudsService.GetSavedCarsByUser:output_type -> cruds.ListSavedCarsResponse
+	61,  // 97: cruds.CrudsService.DeleteSavedCar:output_type -> cruds.Empty
+	61,  // 98: cruds.CrudsService.DeleteSavedCarsByCarId:output_type -> cruds.Empty
+	70,  // 99: cruds.CrudsService.CheckSavedCarOwnership:output_type -> cruds.BoolCheck
+	61,  // 100: cruds.CrudsService.CreateNotification:output_type -> cruds.Empty
+	72,  // 101: cruds.CrudsService.GetAllNotificationsByUserId:output_type -> cruds.ListNotificationsResponse
+	72,  // 102: cruds.CrudsService.GetUnreadNotifications:output_type -> cruds.ListNotificationsResponse
+	61,  // 103: cruds.CrudsService.MarkNotificationAsRead:output_type -> cruds.Empty
+	61,  // 104: cruds.CrudsService.DeleteNotification:output_type -> cruds.Empty
+	61,  // 105: cruds.CrudsService.RestoreNotification:output_type -> cruds.Empty
+	73,  // 106: cruds.CrudsService.SendMessage:output_type -> cruds.Message
+	74,  // 107: cruds.CrudsService.GetMessagesByUser:output_type -> cruds.ListMessagesResponse
+	61,  // 108: cruds.CrudsService.MarkMessageAsRead:output_type -> cruds.Empty
+	61,  // 109: cruds.CrudsService.DeleteMessage:output_type -> cruds.Empty
+	70,  // 110: cruds.CrudsService.CheckMessageOwnership:output_type -> cruds.BoolCheck
+	75,  // 111: cruds.CrudsService.GetMessageByUserAndId:output_type -> cruds.GetMessageByUserAndIdRes
+	61,  // 112: cruds.CrudsService.RegisterNotificationToken:output_type -> cruds.Empty
+	76,  // 113: cruds.CrudsService.GetNotificationTokensByUserId:output_type -> cruds.ListNotificationTokensResponse
+	61,  // 114: cruds.CrudsService.DeleteNotificationToken:output_type -> cruds.Empty
+	77,  // 115: cruds.CrudsService.AddImage:output_type -> cruds.Image
+	78,  // 116: cruds.CrudsService.GetImagesByCar:output_type -> cruds.ListImagesResponse
+	61,  // 117: cruds.CrudsService.DeleteImage:output_type -> cruds.Empty
+	61,  // 118: cruds.CrudsService.DeleteImagesByCarId:output_type -> cruds.Empty
+	61,  // 119: cruds.CrudsService.RestoreImage:output_type -> cruds.Empty
+	77,  // 120: cruds.CrudsService.GetImageByID:output_type -> cruds.Image
+	79,  // 121: cruds.CrudsService.CreateImageUploadURL:output_type -> cruds.SignedURL
+	79,  // 122: cruds.CrudsService.CreateImageDownloadURL:output_type -> cruds.SignedURL
+	78,  // 123: cruds.CrudsService.ReorderCarImages:output_type -> cruds.ListImagesResponse
+	80,  // 124: cruds.CrudsService.AddCarMedia:output_type -> cruds.MediaItem
+	61,  // 125: cruds.CrudsService.AuthorizeCarUpload:output_type -> cruds.Empty
+	81,  // 126: cruds.CrudsService.ListCarMedia:output_type -> cruds.ListCarMediaResponse
+	61,  // 127: cruds.CrudsService.DeleteCarMedia:output_type -> cruds.Empty
+	82,  // 128: cruds.CrudsService.CreateComment:output_type -> cruds.Comment
+	83,  // 129: cruds.CrudsService.GetCommentsByCar:output_type -> cruds.ListCommentsResponse
+	61,  // 130: cruds.CrudsService.UpdateComment:output_type -> cruds.Empty
+	61,  // 131: cruds.CrudsService.DeleteComment:output_type -> cruds.Empty
+	61,  // 132: cruds.CrudsService.DeleteCommentsByCarId:output_type -> cruds.Empty
+	84,  // 133: cruds.CrudsService.ReactToComment:output_type -> cruds.CommentReactions
+	84,  // 134: cruds.CrudsService.RemoveCommentReaction:output_type -> cruds.CommentReactions
+	61,  // 135: cruds.CrudsService.RestoreComment:output_type -> cruds.Empty
+	70,  // 136: cruds.CrudsService.CheckCommentOwnership:output_type -> cruds.BoolCheck
+	85,  // 137: cruds.CrudsService.CreateReview:output_type -> cruds.Review
+	85,  // 138: cruds.CrudsService.UpdateReview:output_type -> cruds.Review
+	61,  // 139: cruds.CrudsService.DeleteReview:output_type -> cruds.Empty
+	86,  // 140: cruds.CrudsService.ListReviews:output_type -> cruds.ListReviewsResponse
+	87,  // 141: cruds.CrudsService.GetSellerSummary:output_type -> cruds.SellerSummary
+	88,  // 142: cruds.CrudsService.GetAuditTrail:output_type -> cruds.ListAuditEntriesResponse
+	89,  // 143: cruds.CrudsService.ListModerationQueue:output_type -> cruds.ListModerationItemsResponse
+	90,  // 144: cruds.CrudsService.ListImageMatches:output_type -> cruds.ListImageMatchesResponse
+	61,  // 145: cruds.CrudsService.ApproveModerationItem:output_type -> cruds.Empty
+	61,  // 146: cruds.CrudsService.RejectModerationItem:output_type -> cruds.Empty
+	61,  // 147: cruds.CrudsService.RequestModerationChanges:output_type -> cruds.Empty
+	91,  // 148: cruds.CrudsService.ReportContent:output_type -> cruds.Report
+	92,  // 149: cruds.CrudsService.ListReports:output_type -> cruds.ListReportsResponse
+	61,  // 150: cruds.CrudsService.TriageReport:output_type -> cruds.Empty
+	61,  // 151: cruds.CrudsService.ResolveReport:output_type -> cruds.Empty
+	93,  // 152: cruds.CrudsService.EstimateCarPrice:output_type -> cruds.PriceEstimate
+	94,  // 153: cruds.CrudsService.UpsertCatalogEntry:output_type -> cruds.CatalogEntry
+	61,  // 154: cruds.CrudsService.DeleteCatalogEntry:output_type -> cruds.Empty
+	95,  // 155: cruds.CrudsService.AutocompleteMakeModel:output_type -> cruds.AutocompleteMakeModelResponse
+	96,  // 156: cruds.CrudsService.SuggestSearch:output_type -> cruds.SuggestSearchResponse
+	97,  // 157: cruds.CrudsService.GetTrendingSearches:output_type -> cruds.TrendingSearchesResponse
+	98,  // 158: cruds.CrudsService.InitiateCarTransfer:output_type -> cruds.CarTransfer
+	99,  // 159: cruds.CrudsService.ListCarTransfers:output_type -> cruds.ListCarTransfersResponse
+	61,  // 160: cruds.CrudsService.AcceptCarTransfer:output_type -> cruds.Empty
+	61,  // 161: cruds.CrudsService.DeclineCarTransfer:output_type -> cruds.Empty
+	61,  // 162: cruds.CrudsService.CancelCarTransfer:output_type -> cruds.Empty
+	100, // 163: cruds.CrudsService.CreateOrganization:output_type -> cruds.Organization
+	101, // 164: cruds.CrudsService.ListMyOrganizations:output_type -> cruds.ListOrganizationsResponse
+	100, // 165: cruds.CrudsService.GetOrganization:output_type -> cruds.Organization
+	61,  // 166: cruds.CrudsService.AddOrganizationMember:output_type -> cruds.Empty
+	61,  // 167: cruds.CrudsService.RemoveOrganizationMember:output_type -> cruds.Empty
+	61,  // 168: cruds.CrudsService.AssignCarToOrganization:output_type -> cruds.Empty
+	102, // 169: cruds.CrudsService.ListOrganizationMessages:output_type -> cruds.OrganizationMessagesResponse
+	103, // 170: cruds.CrudsService.GetMyQuota:output_type -> cruds.Quota
+	61,  // 171: cruds.CrudsService.SetUserPlan:output_type -> cruds.Empty
+	86,  // [86:172] is the sub-list for method output_type
+	0,   // [0:86] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_cruds_cruds_proto_init() }
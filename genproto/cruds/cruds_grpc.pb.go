@@ -24,6 +24,7 @@ const (
 	CrudsService_ListCars_FullMethodName                      = "/cruds.CrudsService/ListCars"
 	CrudsService_UpdateCar_FullMethodName                     = "/cruds.CrudsService/UpdateCar"
 	CrudsService_DeleteCar_FullMethodName                     = "/cruds.CrudsService/DeleteCar"
+	CrudsService_RestoreCar_FullMethodName                    = "/cruds.CrudsService/RestoreCar"
 	CrudsService_IncrementCarReviewCount_FullMethodName       = "/cruds.CrudsService/IncrementCarReviewCount"
 	CrudsService_SearchCar_FullMethodName                     = "/cruds.CrudsService/SearchCar"
 	CrudsService_CheckCarOwnership_FullMethodName             = "/cruds.CrudsService/CheckCarOwnership"
@@ -37,6 +38,7 @@ const (
 	CrudsService_GetUnreadNotifications_FullMethodName        = "/cruds.CrudsService/GetUnreadNotifications"
 	CrudsService_MarkNotificationAsRead_FullMethodName        = "/cruds.CrudsService/MarkNotificationAsRead"
 	CrudsService_DeleteNotification_FullMethodName            = "/cruds.CrudsService/DeleteNotification"
+	CrudsService_RestoreNotification_FullMethodName           = "/cruds.CrudsService/RestoreNotification"
 	CrudsService_SendMessage_FullMethodName                   = "/cruds.CrudsService/SendMessage"
 	CrudsService_GetMessagesByUser_FullMethodName             = "/cruds.CrudsService/GetMessagesByUser"
 	CrudsService_MarkMessageAsRead_FullMethodName             = "/cruds.CrudsService/MarkMessageAsRead"
@@ -50,13 +52,59 @@ const (
 	CrudsService_GetImagesByCar_FullMethodName                = "/cruds.CrudsService/GetImagesByCar"
 	CrudsService_DeleteImage_FullMethodName                   = "/cruds.CrudsService/DeleteImage"
 	CrudsService_DeleteImagesByCarId_FullMethodName           = "/cruds.CrudsService/DeleteImagesByCarId"
+	CrudsService_RestoreImage_FullMethodName                  = "/cruds.CrudsService/RestoreImage"
 	CrudsService_GetImageByID_FullMethodName                  = "/cruds.CrudsService/GetImageByID"
+	CrudsService_CreateImageUploadURL_FullMethodName          = "/cruds.CrudsService/CreateImageUploadURL"
+	CrudsService_CreateImageDownloadURL_FullMethodName        = "/cruds.CrudsService/CreateImageDownloadURL"
+	CrudsService_ReorderCarImages_FullMethodName              = "/cruds.CrudsService/ReorderCarImages"
+	CrudsService_AddCarMedia_FullMethodName                   = "/cruds.CrudsService/AddCarMedia"
+	CrudsService_AuthorizeCarUpload_FullMethodName            = "/cruds.CrudsService/AuthorizeCarUpload"
+	CrudsService_ListCarMedia_FullMethodName                  = "/cruds.CrudsService/ListCarMedia"
+	CrudsService_DeleteCarMedia_FullMethodName                = "/cruds.CrudsService/DeleteCarMedia"
 	CrudsService_CreateComment_FullMethodName                 = "/cruds.CrudsService/CreateComment"
 	CrudsService_GetCommentsByCar_FullMethodName              = "/cruds.CrudsService/GetCommentsByCar"
 	CrudsService_UpdateComment_FullMethodName                 = "/cruds.CrudsService/UpdateComment"
 	CrudsService_DeleteComment_FullMethodName                 = "/cruds.CrudsService/DeleteComment"
 	CrudsService_DeleteCommentsByCarId_FullMethodName         = "/cruds.CrudsService/DeleteCommentsByCarId"
+	CrudsService_ReactToComment_FullMethodName                = "/cruds.CrudsService/ReactToComment"
+	CrudsService_RemoveCommentReaction_FullMethodName         = "/cruds.CrudsService/RemoveCommentReaction"
+	CrudsService_RestoreComment_FullMethodName                = "/cruds.CrudsService/RestoreComment"
 	CrudsService_CheckCommentOwnership_FullMethodName         = "/cruds.CrudsService/CheckCommentOwnership"
+	CrudsService_CreateReview_FullMethodName                  = "/cruds.CrudsService/CreateReview"
+	CrudsService_UpdateReview_FullMethodName                  = "/cruds.CrudsService/UpdateReview"
+	CrudsService_DeleteReview_FullMethodName                  = "/cruds.CrudsService/DeleteReview"
+	CrudsService_ListReviews_FullMethodName                   = "/cruds.CrudsService/ListReviews"
+	CrudsService_GetSellerSummary_FullMethodName              = "/cruds.CrudsService/GetSellerSummary"
+	CrudsService_GetAuditTrail_FullMethodName                 = "/cruds.CrudsService/GetAuditTrail"
+	CrudsService_ListModerationQueue_FullMethodName           = "/cruds.CrudsService/ListModerationQueue"
+	CrudsService_ListImageMatches_FullMethodName              = "/cruds.CrudsService/ListImageMatches"
+	CrudsService_ApproveModerationItem_FullMethodName         = "/cruds.CrudsService/ApproveModerationItem"
+	CrudsService_RejectModerationItem_FullMethodName          = "/cruds.CrudsService/RejectModerationItem"
+	CrudsService_RequestModerationChanges_FullMethodName      = "/cruds.CrudsService/RequestModerationChanges"
+	CrudsService_ReportContent_FullMethodName                 = "/cruds.CrudsService/ReportContent"
+	CrudsService_ListReports_FullMethodName                   = "/cruds.CrudsService/ListReports"
+	CrudsService_TriageReport_FullMethodName                  = "/cruds.CrudsService/TriageReport"
+	CrudsService_ResolveReport_FullMethodName                 = "/cruds.CrudsService/ResolveReport"
+	CrudsService_EstimateCarPrice_FullMethodName              = "/cruds.CrudsService/EstimateCarPrice"
+	CrudsService_UpsertCatalogEntry_FullMethodName            = "/cruds.CrudsService/UpsertCatalogEntry"
+	CrudsService_DeleteCatalogEntry_FullMethodName            = "/cruds.CrudsService/DeleteCatalogEntry"
+	CrudsService_AutocompleteMakeModel_FullMethodName         = "/cruds.CrudsService/AutocompleteMakeModel"
+	CrudsService_SuggestSearch_FullMethodName                 = "/cruds.CrudsService/SuggestSearch"
+	CrudsService_GetTrendingSearches_FullMethodName           = "/cruds.CrudsService/GetTrendingSearches"
+	CrudsService_InitiateCarTransfer_FullMethodName           = "/cruds.CrudsService/InitiateCarTransfer"
+	CrudsService_ListCarTransfers_FullMethodName              = "/cruds.CrudsService/ListCarTransfers"
+	CrudsService_AcceptCarTransfer_FullMethodName             = "/cruds.CrudsService/AcceptCarTransfer"
+	CrudsService_DeclineCarTransfer_FullMethodName            = "/cruds.CrudsService/DeclineCarTransfer"
+	CrudsService_CancelCarTransfer_FullMethodName             = "/cruds.CrudsService/CancelCarTransfer"
+	CrudsService_CreateOrganization_FullMethodName            = "/cruds.CrudsService/CreateOrganization"
+	CrudsService_ListMyOrganizations_FullMethodName           = "/cruds.CrudsService/ListMyOrganizations"
+	CrudsService_GetOrganization_FullMethodName               = "/cruds.CrudsService/GetOrganization"
+	CrudsService_AddOrganizationMember_FullMethodName         = "/cruds.CrudsService/AddOrganizationMember"
+	CrudsService_RemoveOrganizationMember_FullMethodName      = "/cruds.CrudsService/RemoveOrganizationMember"
+	CrudsService_AssignCarToOrganization_FullMethodName       = "/cruds.CrudsService/AssignCarToOrganization"
+	CrudsService_ListOrganizationMessages_FullMethodName      = "/cruds.CrudsService/ListOrganizationMessages"
+	CrudsService_GetMyQuota_FullMethodName                    = "/cruds.CrudsService/GetMyQuota"
+	CrudsService_SetUserPlan_FullMethodName                   = "/cruds.CrudsService/SetUserPlan"
 )
 
 // CrudsServiceClient is the client API for CrudsService service.
@@ -69,6 +117,7 @@ type CrudsServiceClient interface {
 	ListCars(ctx context.Context, in *ListCarsRequest, opts ...grpc.CallOption) (*ListCarsResponse, error)
 	UpdateCar(ctx context.Context, in *UpdateCarRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteCar(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Empty, error)
+	RestoreCar(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Empty, error)
 	IncrementCarReviewCount(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Empty, error)
 	SearchCar(ctx context.Context, in *SearchCarRequest, opts ...grpc.CallOption) (*ListCarsResponse, error)
 	CheckCarOwnership(ctx context.Context, in *BoolCheckCar, opts ...grpc.CallOption) (*BoolCheck, error)
@@ -84,6 +133,7 @@ type CrudsServiceClient interface {
 	GetUnreadNotifications(ctx context.Context, in *GetUnreadNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
 	MarkNotificationAsRead(ctx context.Context, in *MarkNotificationAsReadRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteNotification(ctx context.Context, in *DeleteNotificationRequest, opts ...grpc.CallOption) (*Empty, error)
+	RestoreNotification(ctx context.Context, in *RestoreNotificationRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Messages
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*Message, error)
 	GetMessagesByUser(ctx context.Context, in *GetMessagesByUserRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
@@ -100,14 +150,72 @@ type CrudsServiceClient interface {
 	GetImagesByCar(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*ListImagesResponse, error)
 	DeleteImage(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Empty, error)
 	DeleteImagesByCarId(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*Empty, error)
+	RestoreImage(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Empty, error)
 	GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error)
+	CreateImageUploadURL(ctx context.Context, in *CreateImageUploadURLRequest, opts ...grpc.CallOption) (*SignedURL, error)
+	CreateImageDownloadURL(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*SignedURL, error)
+	ReorderCarImages(ctx context.Context, in *ReorderCarImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error)
+	// Media
+	AddCarMedia(ctx context.Context, in *AddCarMediaRequest, opts ...grpc.CallOption) (*MediaItem, error)
+	// Upload handler fayl tanasini o'qishdan oldin e'lonni tahrirlash huquqini tekshiradi
+	AuthorizeCarUpload(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*Empty, error)
+	ListCarMedia(ctx context.Context, in *ListCarMediaRequest, opts ...grpc.CallOption) (*ListCarMediaResponse, error)
+	DeleteCarMedia(ctx context.Context, in *MediaId, opts ...grpc.CallOption) (*Empty, error)
 	// Comments
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
-	GetCommentsByCar(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	GetCommentsByCar(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
 	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Empty, error)
 	DeleteComment(ctx context.Context, in *CommentId, opts ...grpc.CallOption) (*Empty, error)
 	DeleteCommentsByCarId(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*Empty, error)
+	ReactToComment(ctx context.Context, in *ReactToCommentRequest, opts ...grpc.CallOption) (*CommentReactions, error)
+	RemoveCommentReaction(ctx context.Context, in *CommentId, opts ...grpc.CallOption) (*CommentReactions, error)
+	RestoreComment(ctx context.Context, in *CommentId, opts ...grpc.CallOption) (*Empty, error)
 	CheckCommentOwnership(ctx context.Context, in *BoolCheckComment, opts ...grpc.CallOption) (*BoolCheck, error)
+	// Reviews
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	DeleteReview(ctx context.Context, in *ReviewId, opts ...grpc.CallOption) (*Empty, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	GetSellerSummary(ctx context.Context, in *SellerSummaryRequest, opts ...grpc.CallOption) (*SellerSummary, error)
+	// Audit
+	GetAuditTrail(ctx context.Context, in *GetAuditTrailRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	// Moderation
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationItemsResponse, error)
+	ListImageMatches(ctx context.Context, in *ListImageMatchesRequest, opts ...grpc.CallOption) (*ListImageMatchesResponse, error)
+	ApproveModerationItem(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error)
+	RejectModerationItem(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error)
+	RequestModerationChanges(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Reports
+	ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*Report, error)
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	TriageReport(ctx context.Context, in *TriageReportRequest, opts ...grpc.CallOption) (*Empty, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Pricing
+	EstimateCarPrice(ctx context.Context, in *EstimateCarPriceRequest, opts ...grpc.CallOption) (*PriceEstimate, error)
+	// Catalog
+	UpsertCatalogEntry(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error)
+	DeleteCatalogEntry(ctx context.Context, in *DeleteCatalogEntryRequest, opts ...grpc.CallOption) (*Empty, error)
+	AutocompleteMakeModel(ctx context.Context, in *AutocompleteMakeModelRequest, opts ...grpc.CallOption) (*AutocompleteMakeModelResponse, error)
+	// Search
+	SuggestSearch(ctx context.Context, in *SuggestSearchRequest, opts ...grpc.CallOption) (*SuggestSearchResponse, error)
+	GetTrendingSearches(ctx context.Context, in *GetTrendingSearchesRequest, opts ...grpc.CallOption) (*TrendingSearchesResponse, error)
+	// Ownership transfers
+	InitiateCarTransfer(ctx context.Context, in *InitiateCarTransferRequest, opts ...grpc.CallOption) (*CarTransfer, error)
+	ListCarTransfers(ctx context.Context, in *ListCarTransfersRequest, opts ...grpc.CallOption) (*ListCarTransfersResponse, error)
+	AcceptCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error)
+	DeclineCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error)
+	CancelCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error)
+	// Dealer organizations
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	ListMyOrganizations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	GetOrganization(ctx context.Context, in *OrganizationId, opts ...grpc.CallOption) (*Organization, error)
+	AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*Empty, error)
+	AssignCarToOrganization(ctx context.Context, in *AssignCarToOrganizationRequest, opts ...grpc.CallOption) (*Empty, error)
+	ListOrganizationMessages(ctx context.Context, in *ListOrganizationMessagesRequest, opts ...grpc.CallOption) (*OrganizationMessagesResponse, error)
+	// Plans and quotas
+	GetMyQuota(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Quota, error)
+	SetUserPlan(ctx context.Context, in *SetUserPlanRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type crudsServiceClient struct {
@@ -168,6 +276,16 @@ func (c *crudsServiceClient) DeleteCar(ctx context.Context, in *Id, opts ...grpc
 	return out, nil
 }
 
+func (c *crudsServiceClient) RestoreCar(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RestoreCar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudsServiceClient) IncrementCarReviewCount(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
@@ -298,6 +416,16 @@ func (c *crudsServiceClient) DeleteNotification(ctx context.Context, in *DeleteN
 	return out, nil
 }
 
+func (c *crudsServiceClient) RestoreNotification(ctx context.Context, in *RestoreNotificationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RestoreNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudsServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*Message, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Message)
@@ -428,6 +556,16 @@ func (c *crudsServiceClient) DeleteImagesByCarId(ctx context.Context, in *CarId,
 	return out, nil
 }
 
+func (c *crudsServiceClient) RestoreImage(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RestoreImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudsServiceClient) GetImageByID(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*Image, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Image)
@@ -438,6 +576,76 @@ func (c *crudsServiceClient) GetImageByID(ctx context.Context, in *ImageId, opts
 	return out, nil
 }
 
+func (c *crudsServiceClient) CreateImageUploadURL(ctx context.Context, in *CreateImageUploadURLRequest, opts ...grpc.CallOption) (*SignedURL, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignedURL)
+	err := c.cc.Invoke(ctx, CrudsService_CreateImageUploadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) CreateImageDownloadURL(ctx context.Context, in *ImageId, opts ...grpc.CallOption) (*SignedURL, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignedURL)
+	err := c.cc.Invoke(ctx, CrudsService_CreateImageDownloadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) ReorderCarImages(ctx context.Context, in *ReorderCarImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImagesResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ReorderCarImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) AddCarMedia(ctx context.Context, in *AddCarMediaRequest, opts ...grpc.CallOption) (*MediaItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaItem)
+	err := c.cc.Invoke(ctx, CrudsService_AddCarMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) AuthorizeCarUpload(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_AuthorizeCarUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) ListCarMedia(ctx context.Context, in *ListCarMediaRequest, opts ...grpc.CallOption) (*ListCarMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCarMediaResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListCarMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) DeleteCarMedia(ctx context.Context, in *MediaId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_DeleteCarMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudsServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Comment)
@@ -448,7 +656,7 @@ func (c *crudsServiceClient) CreateComment(ctx context.Context, in *CreateCommen
 	return out, nil
 }
 
-func (c *crudsServiceClient) GetCommentsByCar(ctx context.Context, in *CarId, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+func (c *crudsServiceClient) GetCommentsByCar(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListCommentsResponse)
 	err := c.cc.Invoke(ctx, CrudsService_GetCommentsByCar_FullMethodName, in, out, cOpts...)
@@ -488,6 +696,36 @@ func (c *crudsServiceClient) DeleteCommentsByCarId(ctx context.Context, in *CarI
 	return out, nil
 }
 
+func (c *crudsServiceClient) ReactToComment(ctx context.Context, in *ReactToCommentRequest, opts ...grpc.CallOption) (*CommentReactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentReactions)
+	err := c.cc.Invoke(ctx, CrudsService_ReactToComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) RemoveCommentReaction(ctx context.Context, in *CommentId, opts ...grpc.CallOption) (*CommentReactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentReactions)
+	err := c.cc.Invoke(ctx, CrudsService_RemoveCommentReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) RestoreComment(ctx context.Context, in *CommentId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RestoreComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *crudsServiceClient) CheckCommentOwnership(ctx context.Context, in *BoolCheckComment, opts ...grpc.CallOption) (*BoolCheck, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BoolCheck)
@@ -498,880 +736,2298 @@ func (c *crudsServiceClient) CheckCommentOwnership(ctx context.Context, in *Bool
 	return out, nil
 }
 
-// CrudsServiceServer is the server API for CrudsService service.
-// All implementations must embed UnimplementedCrudsServiceServer
-// for forward compatibility.
-type CrudsServiceServer interface {
-	// Cars
-	CreateCar(context.Context, *CreateCarRequest) (*Car, error)
-	GetCarById(context.Context, *Id) (*Car, error)
-	ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error)
-	UpdateCar(context.Context, *UpdateCarRequest) (*Empty, error)
-	DeleteCar(context.Context, *Id) (*Empty, error)
-	IncrementCarReviewCount(context.Context, *Id) (*Empty, error)
-	SearchCar(context.Context, *SearchCarRequest) (*ListCarsResponse, error)
-	CheckCarOwnership(context.Context, *BoolCheckCar) (*BoolCheck, error)
-	// Saved Cars
-	SaveCar(context.Context, *SaveCarRequest) (*Empty, error)
-	GetSavedCarsByUser(context.Context, *GetSavedCarsRequest) (*ListSavedCarsResponse, error)
-	DeleteSavedCar(context.Context, *DeleteSavedCarRequest) (*Empty, error)
-	DeleteSavedCarsByCarId(context.Context, *CarId) (*Empty, error)
-	CheckSavedCarOwnership(context.Context, *BoolCheckSavedCars) (*BoolCheck, error)
-	// Notifications
-	CreateNotification(context.Context, *CreateNotificationRequest) (*Empty, error)
-	GetAllNotificationsByUserId(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error)
-	GetUnreadNotifications(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error)
-	MarkNotificationAsRead(context.Context, *MarkNotificationAsReadRequest) (*Empty, error)
-	DeleteNotification(context.Context, *DeleteNotificationRequest) (*Empty, error)
-	// Messages
-	SendMessage(context.Context, *SendMessageRequest) (*Message, error)
-	GetMessagesByUser(context.Context, *GetMessagesByUserRequest) (*ListMessagesResponse, error)
-	MarkMessageAsRead(context.Context, *MessageId) (*Empty, error)
-	DeleteMessage(context.Context, *DeleteMessageRequest) (*Empty, error)
-	CheckMessageOwnership(context.Context, *BoolCheckMessage) (*BoolCheck, error)
-	GetMessageByUserAndId(context.Context, *GetMessageByUserAndIdReq) (*GetMessageByUserAndIdRes, error)
-	// Notification Tokens
-	RegisterNotificationToken(context.Context, *RegisterNotificationTokenRequest) (*Empty, error)
-	GetNotificationTokensByUserId(context.Context, *GetNotificationTokensByUserIdRequest) (*ListNotificationTokensResponse, error)
-	DeleteNotificationToken(context.Context, *DeleteNotificationTokenRequest) (*Empty, error)
-	// Images
-	AddImage(context.Context, *AddImageRequest) (*Image, error)
-	GetImagesByCar(context.Context, *CarId) (*ListImagesResponse, error)
-	DeleteImage(context.Context, *ImageId) (*Empty, error)
-	DeleteImagesByCarId(context.Context, *CarId) (*Empty, error)
-	GetImageByID(context.Context, *ImageId) (*Image, error)
-	// Comments
-	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
-	GetCommentsByCar(context.Context, *CarId) (*ListCommentsResponse, error)
-	UpdateComment(context.Context, *UpdateCommentRequest) (*Empty, error)
-	DeleteComment(context.Context, *CommentId) (*Empty, error)
-	DeleteCommentsByCarId(context.Context, *CarId) (*Empty, error)
-	CheckCommentOwnership(context.Context, *BoolCheckComment) (*BoolCheck, error)
-	mustEmbedUnimplementedCrudsServiceServer()
+func (c *crudsServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, CrudsService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCrudsServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCrudsServiceServer struct{}
+func (c *crudsServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, CrudsService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedCrudsServiceServer) CreateCar(context.Context, *CreateCarRequest) (*Car, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
+func (c *crudsServiceClient) DeleteReview(ctx context.Context, in *ReviewId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetCarById(context.Context, *Id) (*Car, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCarById not implemented")
+
+func (c *crudsServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCars not implemented")
+
+func (c *crudsServiceClient) GetSellerSummary(ctx context.Context, in *SellerSummaryRequest, opts ...grpc.CallOption) (*SellerSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SellerSummary)
+	err := c.cc.Invoke(ctx, CrudsService_GetSellerSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) UpdateCar(context.Context, *UpdateCarRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCar not implemented")
+
+func (c *crudsServiceClient) GetAuditTrail(ctx context.Context, in *GetAuditTrailRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, CrudsService_GetAuditTrail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteCar(context.Context, *Id) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCar not implemented")
+
+func (c *crudsServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListModerationItemsResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListModerationQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) IncrementCarReviewCount(context.Context, *Id) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncrementCarReviewCount not implemented")
+
+func (c *crudsServiceClient) ListImageMatches(ctx context.Context, in *ListImageMatchesRequest, opts ...grpc.CallOption) (*ListImageMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImageMatchesResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListImageMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) SearchCar(context.Context, *SearchCarRequest) (*ListCarsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchCar not implemented")
+
+func (c *crudsServiceClient) ApproveModerationItem(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_ApproveModerationItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) CheckCarOwnership(context.Context, *BoolCheckCar) (*BoolCheck, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCarOwnership not implemented")
+
+func (c *crudsServiceClient) RejectModerationItem(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RejectModerationItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) SaveCar(context.Context, *SaveCarRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveCar not implemented")
+
+func (c *crudsServiceClient) RequestModerationChanges(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RequestModerationChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetSavedCarsByUser(context.Context, *GetSavedCarsRequest) (*ListSavedCarsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSavedCarsByUser not implemented")
+
+func (c *crudsServiceClient) ReportContent(ctx context.Context, in *ReportContentRequest, opts ...grpc.CallOption) (*Report, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Report)
+	err := c.cc.Invoke(ctx, CrudsService_ReportContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteSavedCar(context.Context, *DeleteSavedCarRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedCar not implemented")
+
+func (c *crudsServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteSavedCarsByCarId(context.Context, *CarId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedCarsByCarId not implemented")
+
+func (c *crudsServiceClient) TriageReport(ctx context.Context, in *TriageReportRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_TriageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) CheckSavedCarOwnership(context.Context, *BoolCheckSavedCars) (*BoolCheck, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckSavedCarOwnership not implemented")
+
+func (c *crudsServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) CreateNotification(context.Context, *CreateNotificationRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+
+func (c *crudsServiceClient) EstimateCarPrice(ctx context.Context, in *EstimateCarPriceRequest, opts ...grpc.CallOption) (*PriceEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceEstimate)
+	err := c.cc.Invoke(ctx, CrudsService_EstimateCarPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetAllNotificationsByUserId(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllNotificationsByUserId not implemented")
+
+func (c *crudsServiceClient) UpsertCatalogEntry(ctx context.Context, in *CatalogEntryRequest, opts ...grpc.CallOption) (*CatalogEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogEntry)
+	err := c.cc.Invoke(ctx, CrudsService_UpsertCatalogEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetUnreadNotifications(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotifications not implemented")
+
+func (c *crudsServiceClient) DeleteCatalogEntry(ctx context.Context, in *DeleteCatalogEntryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_DeleteCatalogEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) MarkNotificationAsRead(context.Context, *MarkNotificationAsReadRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationAsRead not implemented")
+
+func (c *crudsServiceClient) AutocompleteMakeModel(ctx context.Context, in *AutocompleteMakeModelRequest, opts ...grpc.CallOption) (*AutocompleteMakeModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutocompleteMakeModelResponse)
+	err := c.cc.Invoke(ctx, CrudsService_AutocompleteMakeModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+
+func (c *crudsServiceClient) SuggestSearch(ctx context.Context, in *SuggestSearchRequest, opts ...grpc.CallOption) (*SuggestSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSearchResponse)
+	err := c.cc.Invoke(ctx, CrudsService_SuggestSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) SendMessage(context.Context, *SendMessageRequest) (*Message, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+
+func (c *crudsServiceClient) GetTrendingSearches(ctx context.Context, in *GetTrendingSearchesRequest, opts ...grpc.CallOption) (*TrendingSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingSearchesResponse)
+	err := c.cc.Invoke(ctx, CrudsService_GetTrendingSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetMessagesByUser(context.Context, *GetMessagesByUserRequest) (*ListMessagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesByUser not implemented")
+
+func (c *crudsServiceClient) InitiateCarTransfer(ctx context.Context, in *InitiateCarTransferRequest, opts ...grpc.CallOption) (*CarTransfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CarTransfer)
+	err := c.cc.Invoke(ctx, CrudsService_InitiateCarTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) MarkMessageAsRead(context.Context, *MessageId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkMessageAsRead not implemented")
+
+func (c *crudsServiceClient) ListCarTransfers(ctx context.Context, in *ListCarTransfersRequest, opts ...grpc.CallOption) (*ListCarTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCarTransfersResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListCarTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+
+func (c *crudsServiceClient) AcceptCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_AcceptCarTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) CheckMessageOwnership(context.Context, *BoolCheckMessage) (*BoolCheck, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckMessageOwnership not implemented")
+
+func (c *crudsServiceClient) DeclineCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_DeclineCarTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetMessageByUserAndId(context.Context, *GetMessageByUserAndIdReq) (*GetMessageByUserAndIdRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMessageByUserAndId not implemented")
+
+func (c *crudsServiceClient) CancelCarTransfer(ctx context.Context, in *CarTransferId, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_CancelCarTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) RegisterNotificationToken(context.Context, *RegisterNotificationTokenRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterNotificationToken not implemented")
+
+func (c *crudsServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, CrudsService_CreateOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) GetNotificationTokensByUserId(context.Context, *GetNotificationTokensByUserIdRequest) (*ListNotificationTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationTokensByUserId not implemented")
+
+func (c *crudsServiceClient) ListMyOrganizations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListMyOrganizations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedCrudsServiceServer) DeleteNotificationToken(context.Context, *DeleteNotificationTokenRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationToken not implemented")
+
+func (c *crudsServiceClient) GetOrganization(ctx context.Context, in *OrganizationId, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, CrudsService_GetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) AddOrganizationMember(ctx context.Context, in *AddOrganizationMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_AddOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) RemoveOrganizationMember(ctx context.Context, in *RemoveOrganizationMemberRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_RemoveOrganizationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) AssignCarToOrganization(ctx context.Context, in *AssignCarToOrganizationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_AssignCarToOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) ListOrganizationMessages(ctx context.Context, in *ListOrganizationMessagesRequest, opts ...grpc.CallOption) (*OrganizationMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrganizationMessagesResponse)
+	err := c.cc.Invoke(ctx, CrudsService_ListOrganizationMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) GetMyQuota(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Quota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quota)
+	err := c.cc.Invoke(ctx, CrudsService_GetMyQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crudsServiceClient) SetUserPlan(ctx context.Context, in *SetUserPlanRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, CrudsService_SetUserPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CrudsServiceServer is the server API for CrudsService service.
+// All implementations must embed UnimplementedCrudsServiceServer
+// for forward compatibility.
+type CrudsServiceServer interface {
+	// Cars
+	CreateCar(context.Context, *CreateCarRequest) (*Car, error)
+	GetCarById(context.Context, *Id) (*Car, error)
+	ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error)
+	UpdateCar(context.Context, *UpdateCarRequest) (*Empty, error)
+	DeleteCar(context.Context, *Id) (*Empty, error)
+	RestoreCar(context.Context, *Id) (*Empty, error)
+	IncrementCarReviewCount(context.Context, *Id) (*Empty, error)
+	SearchCar(context.Context, *SearchCarRequest) (*ListCarsResponse, error)
+	CheckCarOwnership(context.Context, *BoolCheckCar) (*BoolCheck, error)
+	// Saved Cars
+	SaveCar(context.Context, *SaveCarRequest) (*Empty, error)
+	GetSavedCarsByUser(context.Context, *GetSavedCarsRequest) (*ListSavedCarsResponse, error)
+	DeleteSavedCar(context.Context, *DeleteSavedCarRequest) (*Empty, error)
+	DeleteSavedCarsByCarId(context.Context, *CarId) (*Empty, error)
+	CheckSavedCarOwnership(context.Context, *BoolCheckSavedCars) (*BoolCheck, error)
+	// Notifications
+	CreateNotification(context.Context, *CreateNotificationRequest) (*Empty, error)
+	GetAllNotificationsByUserId(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error)
+	GetUnreadNotifications(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationAsRead(context.Context, *MarkNotificationAsReadRequest) (*Empty, error)
+	DeleteNotification(context.Context, *DeleteNotificationRequest) (*Empty, error)
+	RestoreNotification(context.Context, *RestoreNotificationRequest) (*Empty, error)
+	// Messages
+	SendMessage(context.Context, *SendMessageRequest) (*Message, error)
+	GetMessagesByUser(context.Context, *GetMessagesByUserRequest) (*ListMessagesResponse, error)
+	MarkMessageAsRead(context.Context, *MessageId) (*Empty, error)
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*Empty, error)
+	CheckMessageOwnership(context.Context, *BoolCheckMessage) (*BoolCheck, error)
+	GetMessageByUserAndId(context.Context, *GetMessageByUserAndIdReq) (*GetMessageByUserAndIdRes, error)
+	// Notification Tokens
+	RegisterNotificationToken(context.Context, *RegisterNotificationTokenRequest) (*Empty, error)
+	GetNotificationTokensByUserId(context.Context, *GetNotificationTokensByUserIdRequest) (*ListNotificationTokensResponse, error)
+	DeleteNotificationToken(context.Context, *DeleteNotificationTokenRequest) (*Empty, error)
+	// Images
+	AddImage(context.Context, *AddImageRequest) (*Image, error)
+	GetImagesByCar(context.Context, *CarId) (*ListImagesResponse, error)
+	DeleteImage(context.Context, *ImageId) (*Empty, error)
+	DeleteImagesByCarId(context.Context, *CarId) (*Empty, error)
+	RestoreImage(context.Context, *ImageId) (*Empty, error)
+	GetImageByID(context.Context, *ImageId) (*Image, error)
+	CreateImageUploadURL(context.Context, *CreateImageUploadURLRequest) (*SignedURL, error)
+	CreateImageDownloadURL(context.Context, *ImageId) (*SignedURL, error)
+	ReorderCarImages(context.Context, *ReorderCarImagesRequest) (*ListImagesResponse, error)
+	// Media
+	AddCarMedia(context.Context, *AddCarMediaRequest) (*MediaItem, error)
+	// Upload handler fayl tanasini o'qishdan oldin e'lonni tahrirlash huquqini tekshiradi
+	AuthorizeCarUpload(context.Context, *CarId) (*Empty, error)
+	ListCarMedia(context.Context, *ListCarMediaRequest) (*ListCarMediaResponse, error)
+	DeleteCarMedia(context.Context, *MediaId) (*Empty, error)
+	// Comments
+	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
+	GetCommentsByCar(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*Empty, error)
+	DeleteComment(context.Context, *CommentId) (*Empty, error)
+	DeleteCommentsByCarId(context.Context, *CarId) (*Empty, error)
+	ReactToComment(context.Context, *ReactToCommentRequest) (*CommentReactions, error)
+	RemoveCommentReaction(context.Context, *CommentId) (*CommentReactions, error)
+	RestoreComment(context.Context, *CommentId) (*Empty, error)
+	CheckCommentOwnership(context.Context, *BoolCheckComment) (*BoolCheck, error)
+	// Reviews
+	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
+	UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error)
+	DeleteReview(context.Context, *ReviewId) (*Empty, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	GetSellerSummary(context.Context, *SellerSummaryRequest) (*SellerSummary, error)
+	// Audit
+	GetAuditTrail(context.Context, *GetAuditTrailRequest) (*ListAuditEntriesResponse, error)
+	// Moderation
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationItemsResponse, error)
+	ListImageMatches(context.Context, *ListImageMatchesRequest) (*ListImageMatchesResponse, error)
+	ApproveModerationItem(context.Context, *ModerationDecisionRequest) (*Empty, error)
+	RejectModerationItem(context.Context, *ModerationDecisionRequest) (*Empty, error)
+	RequestModerationChanges(context.Context, *ModerationDecisionRequest) (*Empty, error)
+	// Reports
+	ReportContent(context.Context, *ReportContentRequest) (*Report, error)
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	TriageReport(context.Context, *TriageReportRequest) (*Empty, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*Empty, error)
+	// Pricing
+	EstimateCarPrice(context.Context, *EstimateCarPriceRequest) (*PriceEstimate, error)
+	// Catalog
+	UpsertCatalogEntry(context.Context, *CatalogEntryRequest) (*CatalogEntry, error)
+	DeleteCatalogEntry(context.Context, *DeleteCatalogEntryRequest) (*Empty, error)
+	AutocompleteMakeModel(context.Context, *AutocompleteMakeModelRequest) (*AutocompleteMakeModelResponse, error)
+	// Search
+	SuggestSearch(context.Context, *SuggestSearchRequest) (*SuggestSearchResponse, error)
+	GetTrendingSearches(context.Context, *GetTrendingSearchesRequest) (*TrendingSearchesResponse, error)
+	// Ownership transfers
+	InitiateCarTransfer(context.Context, *InitiateCarTransferRequest) (*CarTransfer, error)
+	ListCarTransfers(context.Context, *ListCarTransfersRequest) (*ListCarTransfersResponse, error)
+	AcceptCarTransfer(context.Context, *CarTransferId) (*Empty, error)
+	DeclineCarTransfer(context.Context, *CarTransferId) (*Empty, error)
+	CancelCarTransfer(context.Context, *CarTransferId) (*Empty, error)
+	// Dealer organizations
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error)
+	ListMyOrganizations(context.Context, *Empty) (*ListOrganizationsResponse, error)
+	GetOrganization(context.Context, *OrganizationId) (*Organization, error)
+	AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*Empty, error)
+	RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*Empty, error)
+	AssignCarToOrganization(context.Context, *AssignCarToOrganizationRequest) (*Empty, error)
+	ListOrganizationMessages(context.Context, *ListOrganizationMessagesRequest) (*OrganizationMessagesResponse, error)
+	// Plans and quotas
+	GetMyQuota(context.Context, *Empty) (*Quota, error)
+	SetUserPlan(context.Context, *SetUserPlanRequest) (*Empty, error)
+	mustEmbedUnimplementedCrudsServiceServer()
+}
+
+// UnimplementedCrudsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCrudsServiceServer struct{}
+
+func (UnimplementedCrudsServiceServer) CreateCar(context.Context, *CreateCarRequest) (*Car, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetCarById(context.Context, *Id) (*Car, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCarById not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCars not implemented")
+}
+func (UnimplementedCrudsServiceServer) UpdateCar(context.Context, *UpdateCarRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteCar(context.Context, *Id) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) RestoreCar(context.Context, *Id) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) IncrementCarReviewCount(context.Context, *Id) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrementCarReviewCount not implemented")
+}
+func (UnimplementedCrudsServiceServer) SearchCar(context.Context, *SearchCarRequest) (*ListCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) CheckCarOwnership(context.Context, *BoolCheckCar) (*BoolCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCarOwnership not implemented")
+}
+func (UnimplementedCrudsServiceServer) SaveCar(context.Context, *SaveCarRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetSavedCarsByUser(context.Context, *GetSavedCarsRequest) (*ListSavedCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedCarsByUser not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteSavedCar(context.Context, *DeleteSavedCarRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteSavedCarsByCarId(context.Context, *CarId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedCarsByCarId not implemented")
+}
+func (UnimplementedCrudsServiceServer) CheckSavedCarOwnership(context.Context, *BoolCheckSavedCars) (*BoolCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSavedCarOwnership not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateNotification(context.Context, *CreateNotificationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetAllNotificationsByUserId(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllNotificationsByUserId not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetUnreadNotifications(context.Context, *GetUnreadNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotifications not implemented")
+}
+func (UnimplementedCrudsServiceServer) MarkNotificationAsRead(context.Context, *MarkNotificationAsReadRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationAsRead not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteNotification(context.Context, *DeleteNotificationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotification not implemented")
+}
+func (UnimplementedCrudsServiceServer) RestoreNotification(context.Context, *RestoreNotificationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNotification not implemented")
+}
+func (UnimplementedCrudsServiceServer) SendMessage(context.Context, *SendMessageRequest) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetMessagesByUser(context.Context, *GetMessagesByUserRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesByUser not implemented")
+}
+func (UnimplementedCrudsServiceServer) MarkMessageAsRead(context.Context, *MessageId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkMessageAsRead not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedCrudsServiceServer) CheckMessageOwnership(context.Context, *BoolCheckMessage) (*BoolCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMessageOwnership not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetMessageByUserAndId(context.Context, *GetMessageByUserAndIdReq) (*GetMessageByUserAndIdRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageByUserAndId not implemented")
+}
+func (UnimplementedCrudsServiceServer) RegisterNotificationToken(context.Context, *RegisterNotificationTokenRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNotificationToken not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetNotificationTokensByUserId(context.Context, *GetNotificationTokensByUserIdRequest) (*ListNotificationTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationTokensByUserId not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteNotificationToken(context.Context, *DeleteNotificationTokenRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationToken not implemented")
+}
+func (UnimplementedCrudsServiceServer) AddImage(context.Context, *AddImageRequest) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddImage not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetImagesByCar(context.Context, *CarId) (*ListImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImagesByCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteImage(context.Context, *ImageId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteImagesByCarId(context.Context, *CarId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImagesByCarId not implemented")
+}
+func (UnimplementedCrudsServiceServer) RestoreImage(context.Context, *ImageId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreImage not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetImageByID(context.Context, *ImageId) (*Image, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageByID not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateImageUploadURL(context.Context, *CreateImageUploadURLRequest) (*SignedURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImageUploadURL not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateImageDownloadURL(context.Context, *ImageId) (*SignedURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateImageDownloadURL not implemented")
+}
+func (UnimplementedCrudsServiceServer) ReorderCarImages(context.Context, *ReorderCarImagesRequest) (*ListImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCarImages not implemented")
+}
+func (UnimplementedCrudsServiceServer) AddCarMedia(context.Context, *AddCarMediaRequest) (*MediaItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCarMedia not implemented")
+}
+func (UnimplementedCrudsServiceServer) AuthorizeCarUpload(context.Context, *CarId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeCarUpload not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListCarMedia(context.Context, *ListCarMediaRequest) (*ListCarMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCarMedia not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteCarMedia(context.Context, *MediaId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCarMedia not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetCommentsByCar(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByCar not implemented")
+}
+func (UnimplementedCrudsServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteComment(context.Context, *CommentId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteCommentsByCarId(context.Context, *CarId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentsByCarId not implemented")
+}
+func (UnimplementedCrudsServiceServer) ReactToComment(context.Context, *ReactToCommentRequest) (*CommentReactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToComment not implemented")
+}
+func (UnimplementedCrudsServiceServer) RemoveCommentReaction(context.Context, *CommentId) (*CommentReactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommentReaction not implemented")
+}
+func (UnimplementedCrudsServiceServer) RestoreComment(context.Context, *CommentId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedCrudsServiceServer) CheckCommentOwnership(context.Context, *BoolCheckComment) (*BoolCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCommentOwnership not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedCrudsServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteReview(context.Context, *ReviewId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetSellerSummary(context.Context, *SellerSummaryRequest) (*SellerSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSellerSummary not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetAuditTrail(context.Context, *GetAuditTrailRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditTrail not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListImageMatches(context.Context, *ListImageMatchesRequest) (*ListImageMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImageMatches not implemented")
+}
+func (UnimplementedCrudsServiceServer) ApproveModerationItem(context.Context, *ModerationDecisionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveModerationItem not implemented")
+}
+func (UnimplementedCrudsServiceServer) RejectModerationItem(context.Context, *ModerationDecisionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectModerationItem not implemented")
+}
+func (UnimplementedCrudsServiceServer) RequestModerationChanges(context.Context, *ModerationDecisionRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestModerationChanges not implemented")
+}
+func (UnimplementedCrudsServiceServer) ReportContent(context.Context, *ReportContentRequest) (*Report, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportContent not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedCrudsServiceServer) TriageReport(context.Context, *TriageReportRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriageReport not implemented")
+}
+func (UnimplementedCrudsServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedCrudsServiceServer) EstimateCarPrice(context.Context, *EstimateCarPriceRequest) (*PriceEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCarPrice not implemented")
+}
+func (UnimplementedCrudsServiceServer) UpsertCatalogEntry(context.Context, *CatalogEntryRequest) (*CatalogEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertCatalogEntry not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeleteCatalogEntry(context.Context, *DeleteCatalogEntryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogEntry not implemented")
+}
+func (UnimplementedCrudsServiceServer) AutocompleteMakeModel(context.Context, *AutocompleteMakeModelRequest) (*AutocompleteMakeModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteMakeModel not implemented")
+}
+func (UnimplementedCrudsServiceServer) SuggestSearch(context.Context, *SuggestSearchRequest) (*SuggestSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSearch not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetTrendingSearches(context.Context, *GetTrendingSearchesRequest) (*TrendingSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrendingSearches not implemented")
+}
+func (UnimplementedCrudsServiceServer) InitiateCarTransfer(context.Context, *InitiateCarTransferRequest) (*CarTransfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateCarTransfer not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListCarTransfers(context.Context, *ListCarTransfersRequest) (*ListCarTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCarTransfers not implemented")
+}
+func (UnimplementedCrudsServiceServer) AcceptCarTransfer(context.Context, *CarTransferId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCarTransfer not implemented")
+}
+func (UnimplementedCrudsServiceServer) DeclineCarTransfer(context.Context, *CarTransferId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineCarTransfer not implemented")
+}
+func (UnimplementedCrudsServiceServer) CancelCarTransfer(context.Context, *CarTransferId) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCarTransfer not implemented")
+}
+func (UnimplementedCrudsServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListMyOrganizations(context.Context, *Empty) (*ListOrganizationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyOrganizations not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetOrganization(context.Context, *OrganizationId) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedCrudsServiceServer) AddOrganizationMember(context.Context, *AddOrganizationMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationMember not implemented")
+}
+func (UnimplementedCrudsServiceServer) RemoveOrganizationMember(context.Context, *RemoveOrganizationMemberRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationMember not implemented")
+}
+func (UnimplementedCrudsServiceServer) AssignCarToOrganization(context.Context, *AssignCarToOrganizationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCarToOrganization not implemented")
+}
+func (UnimplementedCrudsServiceServer) ListOrganizationMessages(context.Context, *ListOrganizationMessagesRequest) (*OrganizationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMessages not implemented")
+}
+func (UnimplementedCrudsServiceServer) GetMyQuota(context.Context, *Empty) (*Quota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyQuota not implemented")
+}
+func (UnimplementedCrudsServiceServer) SetUserPlan(context.Context, *SetUserPlanRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPlan not implemented")
+}
+func (UnimplementedCrudsServiceServer) mustEmbedUnimplementedCrudsServiceServer() {}
+func (UnimplementedCrudsServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeCrudsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CrudsServiceServer will
+// result in compilation errors.
+type UnsafeCrudsServiceServer interface {
+	mustEmbedUnimplementedCrudsServiceServer()
+}
+
+func RegisterCrudsServiceServer(s grpc.ServiceRegistrar, srv CrudsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCrudsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CrudsService_ServiceDesc, srv)
+}
+
+func _CrudsService_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CreateCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CreateCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CreateCar(ctx, req.(*CreateCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetCarById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetCarById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetCarById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetCarById(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_ListCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).ListCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_ListCars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).ListCars(ctx, req.(*ListCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_UpdateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).UpdateCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_UpdateCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).UpdateCar(ctx, req.(*UpdateCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteCar(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_RestoreCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).RestoreCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_RestoreCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).RestoreCar(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_IncrementCarReviewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).IncrementCarReviewCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_IncrementCarReviewCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).IncrementCarReviewCount(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_SearchCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).SearchCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_SearchCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).SearchCar(ctx, req.(*SearchCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_CheckCarOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoolCheckCar)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CheckCarOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CheckCarOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CheckCarOwnership(ctx, req.(*BoolCheckCar))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_SaveCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).SaveCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_SaveCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).SaveCar(ctx, req.(*SaveCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetSavedCarsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetSavedCarsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetSavedCarsByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetSavedCarsByUser(ctx, req.(*GetSavedCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteSavedCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedCarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteSavedCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteSavedCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteSavedCar(ctx, req.(*DeleteSavedCarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteSavedCarsByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteSavedCarsByCarId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteSavedCarsByCarId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteSavedCarsByCarId(ctx, req.(*CarId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_CheckSavedCarOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoolCheckSavedCars)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CheckSavedCarOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CheckSavedCarOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CheckSavedCarOwnership(ctx, req.(*BoolCheckSavedCars))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CreateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CreateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CreateNotification(ctx, req.(*CreateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetAllNotificationsByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetAllNotificationsByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetAllNotificationsByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetAllNotificationsByUserId(ctx, req.(*GetUnreadNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetUnreadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetUnreadNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetUnreadNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetUnreadNotifications(ctx, req.(*GetUnreadNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_MarkNotificationAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).MarkNotificationAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_MarkNotificationAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).MarkNotificationAsRead(ctx, req.(*MarkNotificationAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteNotification(ctx, req.(*DeleteNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_RestoreNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).RestoreNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_RestoreNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).RestoreNotification(ctx, req.(*RestoreNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetMessagesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessagesByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetMessagesByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetMessagesByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetMessagesByUser(ctx, req.(*GetMessagesByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_MarkMessageAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).MarkMessageAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_MarkMessageAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).MarkMessageAsRead(ctx, req.(*MessageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_CheckMessageOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoolCheckMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CheckMessageOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CheckMessageOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CheckMessageOwnership(ctx, req.(*BoolCheckMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetMessageByUserAndId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageByUserAndIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetMessageByUserAndId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetMessageByUserAndId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetMessageByUserAndId(ctx, req.(*GetMessageByUserAndIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_RegisterNotificationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNotificationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).RegisterNotificationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_RegisterNotificationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).RegisterNotificationToken(ctx, req.(*RegisterNotificationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetNotificationTokensByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationTokensByUserIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetNotificationTokensByUserId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetNotificationTokensByUserId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetNotificationTokensByUserId(ctx, req.(*GetNotificationTokensByUserIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteNotificationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteNotificationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteNotificationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteNotificationToken(ctx, req.(*DeleteNotificationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_AddImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).AddImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_AddImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).AddImage(ctx, req.(*AddImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetImagesByCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetImagesByCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetImagesByCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetImagesByCar(ctx, req.(*CarId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteImage(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_DeleteImagesByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteImagesByCarId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteImagesByCarId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteImagesByCarId(ctx, req.(*CarId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_RestoreImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).RestoreImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_RestoreImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).RestoreImage(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CrudsService_GetImageByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetImageByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetImageByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetImageByID(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) AddImage(context.Context, *AddImageRequest) (*Image, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddImage not implemented")
+
+func _CrudsService_CreateImageUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImageUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CreateImageUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CreateImageUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CreateImageUploadURL(ctx, req.(*CreateImageUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) GetImagesByCar(context.Context, *CarId) (*ListImagesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetImagesByCar not implemented")
+
+func _CrudsService_CreateImageDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CreateImageDownloadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CreateImageDownloadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CreateImageDownloadURL(ctx, req.(*ImageId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) DeleteImage(context.Context, *ImageId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+
+func _CrudsService_ReorderCarImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCarImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).ReorderCarImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_ReorderCarImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).ReorderCarImages(ctx, req.(*ReorderCarImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) DeleteImagesByCarId(context.Context, *CarId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteImagesByCarId not implemented")
+
+func _CrudsService_AddCarMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCarMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).AddCarMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_AddCarMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).AddCarMedia(ctx, req.(*AddCarMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) GetImageByID(context.Context, *ImageId) (*Image, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetImageByID not implemented")
+
+func _CrudsService_AuthorizeCarUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).AuthorizeCarUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_AuthorizeCarUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).AuthorizeCarUpload(ctx, req.(*CarId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+
+func _CrudsService_ListCarMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).ListCarMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_ListCarMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).ListCarMedia(ctx, req.(*ListCarMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) GetCommentsByCar(context.Context, *CarId) (*ListCommentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByCar not implemented")
+
+func _CrudsService_DeleteCarMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteCarMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteCarMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteCarMedia(ctx, req.(*MediaId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+
+func _CrudsService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) DeleteComment(context.Context, *CommentId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+
+func _CrudsService_GetCommentsByCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).GetCommentsByCar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_GetCommentsByCar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).GetCommentsByCar(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) DeleteCommentsByCarId(context.Context, *CarId) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentsByCarId not implemented")
+
+func _CrudsService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) CheckCommentOwnership(context.Context, *BoolCheckComment) (*BoolCheck, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCommentOwnership not implemented")
+
+func _CrudsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteComment(ctx, req.(*CommentId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCrudsServiceServer) mustEmbedUnimplementedCrudsServiceServer() {}
-func (UnimplementedCrudsServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeCrudsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CrudsServiceServer will
-// result in compilation errors.
-type UnsafeCrudsServiceServer interface {
-	mustEmbedUnimplementedCrudsServiceServer()
+func _CrudsService_DeleteCommentsByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).DeleteCommentsByCarId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_DeleteCommentsByCarId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).DeleteCommentsByCarId(ctx, req.(*CarId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCrudsServiceServer(s grpc.ServiceRegistrar, srv CrudsServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCrudsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CrudsService_ReactToComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CrudsService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CrudsServiceServer).ReactToComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CrudsService_ReactToComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrudsServiceServer).ReactToComment(ctx, req.(*ReactToCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CreateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCarRequest)
+func _CrudsService_RemoveCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CreateCar(ctx, in)
+		return srv.(CrudsServiceServer).RemoveCommentReaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CreateCar_FullMethodName,
+		FullMethod: CrudsService_RemoveCommentReaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CreateCar(ctx, req.(*CreateCarRequest))
+		return srv.(CrudsServiceServer).RemoveCommentReaction(ctx, req.(*CommentId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetCarById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
+func _CrudsService_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetCarById(ctx, in)
+		return srv.(CrudsServiceServer).RestoreComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetCarById_FullMethodName,
+		FullMethod: CrudsService_RestoreComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetCarById(ctx, req.(*Id))
+		return srv.(CrudsServiceServer).RestoreComment(ctx, req.(*CommentId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_ListCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCarsRequest)
+func _CrudsService_CheckCommentOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoolCheckComment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).ListCars(ctx, in)
+		return srv.(CrudsServiceServer).CheckCommentOwnership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_ListCars_FullMethodName,
+		FullMethod: CrudsService_CheckCommentOwnership_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).ListCars(ctx, req.(*ListCarsRequest))
+		return srv.(CrudsServiceServer).CheckCommentOwnership(ctx, req.(*BoolCheckComment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_UpdateCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCarRequest)
+func _CrudsService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).UpdateCar(ctx, in)
+		return srv.(CrudsServiceServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_UpdateCar_FullMethodName,
+		FullMethod: CrudsService_CreateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).UpdateCar(ctx, req.(*UpdateCarRequest))
+		return srv.(CrudsServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
+func _CrudsService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteCar(ctx, in)
+		return srv.(CrudsServiceServer).UpdateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteCar_FullMethodName,
+		FullMethod: CrudsService_UpdateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteCar(ctx, req.(*Id))
+		return srv.(CrudsServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_IncrementCarReviewCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Id)
+func _CrudsService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).IncrementCarReviewCount(ctx, in)
+		return srv.(CrudsServiceServer).DeleteReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_IncrementCarReviewCount_FullMethodName,
+		FullMethod: CrudsService_DeleteReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).IncrementCarReviewCount(ctx, req.(*Id))
+		return srv.(CrudsServiceServer).DeleteReview(ctx, req.(*ReviewId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_SearchCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchCarRequest)
+func _CrudsService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).SearchCar(ctx, in)
+		return srv.(CrudsServiceServer).ListReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_SearchCar_FullMethodName,
+		FullMethod: CrudsService_ListReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).SearchCar(ctx, req.(*SearchCarRequest))
+		return srv.(CrudsServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CheckCarOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoolCheckCar)
+func _CrudsService_GetSellerSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellerSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CheckCarOwnership(ctx, in)
+		return srv.(CrudsServiceServer).GetSellerSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CheckCarOwnership_FullMethodName,
+		FullMethod: CrudsService_GetSellerSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CheckCarOwnership(ctx, req.(*BoolCheckCar))
+		return srv.(CrudsServiceServer).GetSellerSummary(ctx, req.(*SellerSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_SaveCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveCarRequest)
+func _CrudsService_GetAuditTrail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditTrailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).SaveCar(ctx, in)
+		return srv.(CrudsServiceServer).GetAuditTrail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_SaveCar_FullMethodName,
+		FullMethod: CrudsService_GetAuditTrail_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).SaveCar(ctx, req.(*SaveCarRequest))
+		return srv.(CrudsServiceServer).GetAuditTrail(ctx, req.(*GetAuditTrailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetSavedCarsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedCarsRequest)
+func _CrudsService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetSavedCarsByUser(ctx, in)
+		return srv.(CrudsServiceServer).ListModerationQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetSavedCarsByUser_FullMethodName,
+		FullMethod: CrudsService_ListModerationQueue_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetSavedCarsByUser(ctx, req.(*GetSavedCarsRequest))
+		return srv.(CrudsServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteSavedCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSavedCarRequest)
+func _CrudsService_ListImageMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImageMatchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteSavedCar(ctx, in)
+		return srv.(CrudsServiceServer).ListImageMatches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteSavedCar_FullMethodName,
+		FullMethod: CrudsService_ListImageMatches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteSavedCar(ctx, req.(*DeleteSavedCarRequest))
+		return srv.(CrudsServiceServer).ListImageMatches(ctx, req.(*ListImageMatchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteSavedCarsByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CarId)
+func _CrudsService_ApproveModerationItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteSavedCarsByCarId(ctx, in)
+		return srv.(CrudsServiceServer).ApproveModerationItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteSavedCarsByCarId_FullMethodName,
+		FullMethod: CrudsService_ApproveModerationItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteSavedCarsByCarId(ctx, req.(*CarId))
+		return srv.(CrudsServiceServer).ApproveModerationItem(ctx, req.(*ModerationDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CheckSavedCarOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoolCheckSavedCars)
+func _CrudsService_RejectModerationItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CheckSavedCarOwnership(ctx, in)
+		return srv.(CrudsServiceServer).RejectModerationItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CheckSavedCarOwnership_FullMethodName,
+		FullMethod: CrudsService_RejectModerationItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CheckSavedCarOwnership(ctx, req.(*BoolCheckSavedCars))
+		return srv.(CrudsServiceServer).RejectModerationItem(ctx, req.(*ModerationDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNotificationRequest)
+func _CrudsService_RequestModerationChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CreateNotification(ctx, in)
+		return srv.(CrudsServiceServer).RequestModerationChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CreateNotification_FullMethodName,
+		FullMethod: CrudsService_RequestModerationChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CreateNotification(ctx, req.(*CreateNotificationRequest))
+		return srv.(CrudsServiceServer).RequestModerationChanges(ctx, req.(*ModerationDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetAllNotificationsByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUnreadNotificationsRequest)
+func _CrudsService_ReportContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetAllNotificationsByUserId(ctx, in)
+		return srv.(CrudsServiceServer).ReportContent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetAllNotificationsByUserId_FullMethodName,
+		FullMethod: CrudsService_ReportContent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetAllNotificationsByUserId(ctx, req.(*GetUnreadNotificationsRequest))
+		return srv.(CrudsServiceServer).ReportContent(ctx, req.(*ReportContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetUnreadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUnreadNotificationsRequest)
+func _CrudsService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetUnreadNotifications(ctx, in)
+		return srv.(CrudsServiceServer).ListReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetUnreadNotifications_FullMethodName,
+		FullMethod: CrudsService_ListReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetUnreadNotifications(ctx, req.(*GetUnreadNotificationsRequest))
+		return srv.(CrudsServiceServer).ListReports(ctx, req.(*ListReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_MarkNotificationAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarkNotificationAsReadRequest)
+func _CrudsService_TriageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriageReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).MarkNotificationAsRead(ctx, in)
+		return srv.(CrudsServiceServer).TriageReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_MarkNotificationAsRead_FullMethodName,
+		FullMethod: CrudsService_TriageReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).MarkNotificationAsRead(ctx, req.(*MarkNotificationAsReadRequest))
+		return srv.(CrudsServiceServer).TriageReport(ctx, req.(*TriageReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNotificationRequest)
+func _CrudsService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteNotification(ctx, in)
+		return srv.(CrudsServiceServer).ResolveReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteNotification_FullMethodName,
+		FullMethod: CrudsService_ResolveReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteNotification(ctx, req.(*DeleteNotificationRequest))
+		return srv.(CrudsServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMessageRequest)
+func _CrudsService_EstimateCarPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateCarPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).SendMessage(ctx, in)
+		return srv.(CrudsServiceServer).EstimateCarPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_SendMessage_FullMethodName,
+		FullMethod: CrudsService_EstimateCarPrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+		return srv.(CrudsServiceServer).EstimateCarPrice(ctx, req.(*EstimateCarPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetMessagesByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessagesByUserRequest)
+func _CrudsService_UpsertCatalogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetMessagesByUser(ctx, in)
+		return srv.(CrudsServiceServer).UpsertCatalogEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetMessagesByUser_FullMethodName,
+		FullMethod: CrudsService_UpsertCatalogEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetMessagesByUser(ctx, req.(*GetMessagesByUserRequest))
+		return srv.(CrudsServiceServer).UpsertCatalogEntry(ctx, req.(*CatalogEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_MarkMessageAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MessageId)
+func _CrudsService_DeleteCatalogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCatalogEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).MarkMessageAsRead(ctx, in)
+		return srv.(CrudsServiceServer).DeleteCatalogEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_MarkMessageAsRead_FullMethodName,
+		FullMethod: CrudsService_DeleteCatalogEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).MarkMessageAsRead(ctx, req.(*MessageId))
+		return srv.(CrudsServiceServer).DeleteCatalogEntry(ctx, req.(*DeleteCatalogEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMessageRequest)
+func _CrudsService_AutocompleteMakeModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteMakeModelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteMessage(ctx, in)
+		return srv.(CrudsServiceServer).AutocompleteMakeModel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteMessage_FullMethodName,
+		FullMethod: CrudsService_AutocompleteMakeModel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+		return srv.(CrudsServiceServer).AutocompleteMakeModel(ctx, req.(*AutocompleteMakeModelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CheckMessageOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoolCheckMessage)
+func _CrudsService_SuggestSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CheckMessageOwnership(ctx, in)
+		return srv.(CrudsServiceServer).SuggestSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CheckMessageOwnership_FullMethodName,
+		FullMethod: CrudsService_SuggestSearch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CheckMessageOwnership(ctx, req.(*BoolCheckMessage))
+		return srv.(CrudsServiceServer).SuggestSearch(ctx, req.(*SuggestSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetMessageByUserAndId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMessageByUserAndIdReq)
+func _CrudsService_GetTrendingSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingSearchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetMessageByUserAndId(ctx, in)
+		return srv.(CrudsServiceServer).GetTrendingSearches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetMessageByUserAndId_FullMethodName,
+		FullMethod: CrudsService_GetTrendingSearches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetMessageByUserAndId(ctx, req.(*GetMessageByUserAndIdReq))
+		return srv.(CrudsServiceServer).GetTrendingSearches(ctx, req.(*GetTrendingSearchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_RegisterNotificationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterNotificationTokenRequest)
+func _CrudsService_InitiateCarTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateCarTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).RegisterNotificationToken(ctx, in)
+		return srv.(CrudsServiceServer).InitiateCarTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_RegisterNotificationToken_FullMethodName,
+		FullMethod: CrudsService_InitiateCarTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).RegisterNotificationToken(ctx, req.(*RegisterNotificationTokenRequest))
+		return srv.(CrudsServiceServer).InitiateCarTransfer(ctx, req.(*InitiateCarTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetNotificationTokensByUserId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNotificationTokensByUserIdRequest)
+func _CrudsService_ListCarTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetNotificationTokensByUserId(ctx, in)
+		return srv.(CrudsServiceServer).ListCarTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetNotificationTokensByUserId_FullMethodName,
+		FullMethod: CrudsService_ListCarTransfers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetNotificationTokensByUserId(ctx, req.(*GetNotificationTokensByUserIdRequest))
+		return srv.(CrudsServiceServer).ListCarTransfers(ctx, req.(*ListCarTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteNotificationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNotificationTokenRequest)
+func _CrudsService_AcceptCarTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarTransferId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteNotificationToken(ctx, in)
+		return srv.(CrudsServiceServer).AcceptCarTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteNotificationToken_FullMethodName,
+		FullMethod: CrudsService_AcceptCarTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteNotificationToken(ctx, req.(*DeleteNotificationTokenRequest))
+		return srv.(CrudsServiceServer).AcceptCarTransfer(ctx, req.(*CarTransferId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_AddImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddImageRequest)
+func _CrudsService_DeclineCarTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarTransferId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).AddImage(ctx, in)
+		return srv.(CrudsServiceServer).DeclineCarTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_AddImage_FullMethodName,
+		FullMethod: CrudsService_DeclineCarTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).AddImage(ctx, req.(*AddImageRequest))
+		return srv.(CrudsServiceServer).DeclineCarTransfer(ctx, req.(*CarTransferId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetImagesByCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CarId)
+func _CrudsService_CancelCarTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CarTransferId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetImagesByCar(ctx, in)
+		return srv.(CrudsServiceServer).CancelCarTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetImagesByCar_FullMethodName,
+		FullMethod: CrudsService_CancelCarTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetImagesByCar(ctx, req.(*CarId))
+		return srv.(CrudsServiceServer).CancelCarTransfer(ctx, req.(*CarTransferId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageId)
+func _CrudsService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteImage(ctx, in)
+		return srv.(CrudsServiceServer).CreateOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteImage_FullMethodName,
+		FullMethod: CrudsService_CreateOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteImage(ctx, req.(*ImageId))
+		return srv.(CrudsServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteImagesByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CarId)
+func _CrudsService_ListMyOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteImagesByCarId(ctx, in)
+		return srv.(CrudsServiceServer).ListMyOrganizations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteImagesByCarId_FullMethodName,
+		FullMethod: CrudsService_ListMyOrganizations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteImagesByCarId(ctx, req.(*CarId))
+		return srv.(CrudsServiceServer).ListMyOrganizations(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetImageByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImageId)
+func _CrudsService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetImageByID(ctx, in)
+		return srv.(CrudsServiceServer).GetOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetImageByID_FullMethodName,
+		FullMethod: CrudsService_GetOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetImageByID(ctx, req.(*ImageId))
+		return srv.(CrudsServiceServer).GetOrganization(ctx, req.(*OrganizationId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentRequest)
+func _CrudsService_AddOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CreateComment(ctx, in)
+		return srv.(CrudsServiceServer).AddOrganizationMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CreateComment_FullMethodName,
+		FullMethod: CrudsService_AddOrganizationMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CreateComment(ctx, req.(*CreateCommentRequest))
+		return srv.(CrudsServiceServer).AddOrganizationMember(ctx, req.(*AddOrganizationMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_GetCommentsByCar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CarId)
+func _CrudsService_RemoveOrganizationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).GetCommentsByCar(ctx, in)
+		return srv.(CrudsServiceServer).RemoveOrganizationMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_GetCommentsByCar_FullMethodName,
+		FullMethod: CrudsService_RemoveOrganizationMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).GetCommentsByCar(ctx, req.(*CarId))
+		return srv.(CrudsServiceServer).RemoveOrganizationMember(ctx, req.(*RemoveOrganizationMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCommentRequest)
+func _CrudsService_AssignCarToOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCarToOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).UpdateComment(ctx, in)
+		return srv.(CrudsServiceServer).AssignCarToOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_UpdateComment_FullMethodName,
+		FullMethod: CrudsService_AssignCarToOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+		return srv.(CrudsServiceServer).AssignCarToOrganization(ctx, req.(*AssignCarToOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentId)
+func _CrudsService_ListOrganizationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationMessagesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteComment(ctx, in)
+		return srv.(CrudsServiceServer).ListOrganizationMessages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteComment_FullMethodName,
+		FullMethod: CrudsService_ListOrganizationMessages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteComment(ctx, req.(*CommentId))
+		return srv.(CrudsServiceServer).ListOrganizationMessages(ctx, req.(*ListOrganizationMessagesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_DeleteCommentsByCarId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CarId)
+func _CrudsService_GetMyQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).DeleteCommentsByCarId(ctx, in)
+		return srv.(CrudsServiceServer).GetMyQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_DeleteCommentsByCarId_FullMethodName,
+		FullMethod: CrudsService_GetMyQuota_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).DeleteCommentsByCarId(ctx, req.(*CarId))
+		return srv.(CrudsServiceServer).GetMyQuota(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CrudsService_CheckCommentOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BoolCheckComment)
+func _CrudsService_SetUserPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CrudsServiceServer).CheckCommentOwnership(ctx, in)
+		return srv.(CrudsServiceServer).SetUserPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CrudsService_CheckCommentOwnership_FullMethodName,
+		FullMethod: CrudsService_SetUserPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CrudsServiceServer).CheckCommentOwnership(ctx, req.(*BoolCheckComment))
+		return srv.(CrudsServiceServer).SetUserPlan(ctx, req.(*SetUserPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1403,6 +3059,10 @@ var CrudsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCar",
 			Handler:    _CrudsService_DeleteCar_Handler,
 		},
+		{
+			MethodName: "RestoreCar",
+			Handler:    _CrudsService_RestoreCar_Handler,
+		},
 		{
 			MethodName: "IncrementCarReviewCount",
 			Handler:    _CrudsService_IncrementCarReviewCount_Handler,
@@ -1455,6 +3115,10 @@ var CrudsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNotification",
 			Handler:    _CrudsService_DeleteNotification_Handler,
 		},
+		{
+			MethodName: "RestoreNotification",
+			Handler:    _CrudsService_RestoreNotification_Handler,
+		},
 		{
 			MethodName: "SendMessage",
 			Handler:    _CrudsService_SendMessage_Handler,
@@ -1507,10 +3171,42 @@ var CrudsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImagesByCarId",
 			Handler:    _CrudsService_DeleteImagesByCarId_Handler,
 		},
+		{
+			MethodName: "RestoreImage",
+			Handler:    _CrudsService_RestoreImage_Handler,
+		},
 		{
 			MethodName: "GetImageByID",
 			Handler:    _CrudsService_GetImageByID_Handler,
 		},
+		{
+			MethodName: "CreateImageUploadURL",
+			Handler:    _CrudsService_CreateImageUploadURL_Handler,
+		},
+		{
+			MethodName: "CreateImageDownloadURL",
+			Handler:    _CrudsService_CreateImageDownloadURL_Handler,
+		},
+		{
+			MethodName: "ReorderCarImages",
+			Handler:    _CrudsService_ReorderCarImages_Handler,
+		},
+		{
+			MethodName: "AddCarMedia",
+			Handler:    _CrudsService_AddCarMedia_Handler,
+		},
+		{
+			MethodName: "AuthorizeCarUpload",
+			Handler:    _CrudsService_AuthorizeCarUpload_Handler,
+		},
+		{
+			MethodName: "ListCarMedia",
+			Handler:    _CrudsService_ListCarMedia_Handler,
+		},
+		{
+			MethodName: "DeleteCarMedia",
+			Handler:    _CrudsService_DeleteCarMedia_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _CrudsService_CreateComment_Handler,
@@ -1531,10 +3227,162 @@ var CrudsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCommentsByCarId",
 			Handler:    _CrudsService_DeleteCommentsByCarId_Handler,
 		},
+		{
+			MethodName: "ReactToComment",
+			Handler:    _CrudsService_ReactToComment_Handler,
+		},
+		{
+			MethodName: "RemoveCommentReaction",
+			Handler:    _CrudsService_RemoveCommentReaction_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _CrudsService_RestoreComment_Handler,
+		},
 		{
 			MethodName: "CheckCommentOwnership",
 			Handler:    _CrudsService_CheckCommentOwnership_Handler,
 		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _CrudsService_CreateReview_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _CrudsService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _CrudsService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _CrudsService_ListReviews_Handler,
+		},
+		{
+			MethodName: "GetSellerSummary",
+			Handler:    _CrudsService_GetSellerSummary_Handler,
+		},
+		{
+			MethodName: "GetAuditTrail",
+			Handler:    _CrudsService_GetAuditTrail_Handler,
+		},
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _CrudsService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ListImageMatches",
+			Handler:    _CrudsService_ListImageMatches_Handler,
+		},
+		{
+			MethodName: "ApproveModerationItem",
+			Handler:    _CrudsService_ApproveModerationItem_Handler,
+		},
+		{
+			MethodName: "RejectModerationItem",
+			Handler:    _CrudsService_RejectModerationItem_Handler,
+		},
+		{
+			MethodName: "RequestModerationChanges",
+			Handler:    _CrudsService_RequestModerationChanges_Handler,
+		},
+		{
+			MethodName: "ReportContent",
+			Handler:    _CrudsService_ReportContent_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _CrudsService_ListReports_Handler,
+		},
+		{
+			MethodName: "TriageReport",
+			Handler:    _CrudsService_TriageReport_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _CrudsService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "EstimateCarPrice",
+			Handler:    _CrudsService_EstimateCarPrice_Handler,
+		},
+		{
+			MethodName: "UpsertCatalogEntry",
+			Handler:    _CrudsService_UpsertCatalogEntry_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogEntry",
+			Handler:    _CrudsService_DeleteCatalogEntry_Handler,
+		},
+		{
+			MethodName: "AutocompleteMakeModel",
+			Handler:    _CrudsService_AutocompleteMakeModel_Handler,
+		},
+		{
+			MethodName: "SuggestSearch",
+			Handler:    _CrudsService_SuggestSearch_Handler,
+		},
+		{
+			MethodName: "GetTrendingSearches",
+			Handler:    _CrudsService_GetTrendingSearches_Handler,
+		},
+		{
+			MethodName: "InitiateCarTransfer",
+			Handler:    _CrudsService_InitiateCarTransfer_Handler,
+		},
+		{
+			MethodName: "ListCarTransfers",
+			Handler:    _CrudsService_ListCarTransfers_Handler,
+		},
+		{
+			MethodName: "AcceptCarTransfer",
+			Handler:    _CrudsService_AcceptCarTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineCarTransfer",
+			Handler:    _CrudsService_DeclineCarTransfer_Handler,
+		},
+		{
+			MethodName: "CancelCarTransfer",
+			Handler:    _CrudsService_CancelCarTransfer_Handler,
+		},
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _CrudsService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListMyOrganizations",
+			Handler:    _CrudsService_ListMyOrganizations_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _CrudsService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "AddOrganizationMember",
+			Handler:    _CrudsService_AddOrganizationMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationMember",
+			Handler:    _CrudsService_RemoveOrganizationMember_Handler,
+		},
+		{
+			MethodName: "AssignCarToOrganization",
+			Handler:    _CrudsService_AssignCarToOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizationMessages",
+			Handler:    _CrudsService_ListOrganizationMessages_Handler,
+		},
+		{
+			MethodName: "GetMyQuota",
+			Handler:    _CrudsService_GetMyQuota_Handler,
+		},
+		{
+			MethodName: "SetUserPlan",
+			Handler:    _CrudsService_SetUserPlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cruds/cruds.proto",
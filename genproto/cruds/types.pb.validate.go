@@ -480,6 +480,8 @@ func (m *UpdateCarRequest) validate(all bool) error {
 
 	// no validation rules for Location
 
+	// no validation rules for Descriptions
+
 	if len(errors) > 0 {
 		return UpdateCarRequestMultiError(errors)
 	}
@@ -598,6 +600,10 @@ func (m *CreateCarRequest) validate(all bool) error {
 
 	// no validation rules for Location
 
+	// no validation rules for Descriptions
+
+	// no validation rules for OrganizationId
+
 	if len(errors) > 0 {
 		return CreateCarRequestMultiError(errors)
 	}
@@ -761,6 +767,20 @@ func (m *Car) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for ModerationStatus
+
+	// no validation rules for PriceBadge
+
+	// no validation rules for DescriptionLocale
+
+	// no validation rules for Descriptions
+
+	// no validation rules for OrganizationId
+
+	// no validation rules for RatingAvg
+
+	// no validation rules for RatingCount
+
 	if len(errors) > 0 {
 		return CarMultiError(errors)
 	}
@@ -2524,6 +2544,110 @@ var _ interface {
 	ErrorName() string
 } = MarkNotificationAsReadRequestValidationError{}
 
+// Validate checks the field values on RestoreNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreNotificationRequestMultiError, or nil if none found.
+func (m *RestoreNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RestoreNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by RestoreNotificationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RestoreNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreNotificationRequestMultiError) AllErrors() []error { return m }
+
+// RestoreNotificationRequestValidationError is the validation error returned
+// by RestoreNotificationRequest.Validate if the designated constraints aren't met.
+type RestoreNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreNotificationRequestValidationError) ErrorName() string {
+	return "RestoreNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreNotificationRequestValidationError{}
+
 // Validate checks the field values on BoolCheckMessage with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2762,6 +2886,8 @@ func (m *Message) validate(all bool) error {
 
 	// no validation rules for CreatedAt
 
+	// no validation rules for CarId
+
 	if len(errors) > 0 {
 		return MessageMultiError(errors)
 	}
@@ -3223,6 +3349,8 @@ func (m *SendMessageRequest) validate(all bool) error {
 
 	// no validation rules for Content
 
+	// no validation rules for CarId
+
 	if len(errors) > 0 {
 		return SendMessageRequestMultiError(errors)
 	}
@@ -4287,6 +4415,24 @@ func (m *Image) validate(all bool) error {
 
 	// no validation rules for UploadedAt
 
+	// no validation rules for Url
+
+	// no validation rules for Variants
+
+	// no validation rules for Position
+
+	// no validation rules for IsCover
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Checksum
+
+	// no validation rules for PossibleDuplicate
+
 	if len(errors) > 0 {
 		return ImageMultiError(errors)
 	}
@@ -4390,6 +4536,18 @@ func (m *AddImageRequest) validate(all bool) error {
 
 	// no validation rules for Filename
 
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Checksum
+
+	// no validation rules for Phash
+
+	// no validation rules for MimeType
+
 	if len(errors) > 0 {
 		return AddImageRequestMultiError(errors)
 	}
@@ -4604,21 +4762,22 @@ var _ interface {
 	ErrorName() string
 } = ListImagesResponseValidationError{}
 
-// Validate checks the field values on ImageId with the rules defined in the
+// Validate checks the field values on MediaItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ImageId) Validate() error {
+func (m *MediaItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImageId with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ImageIdMultiError, or nil if none found.
-func (m *ImageId) ValidateAll() error {
+// ValidateAll checks the field values on MediaItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaItemMultiError, or nil
+// if none found.
+func (m *MediaItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImageId) validate(all bool) error {
+func (m *MediaItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4627,19 +4786,39 @@ func (m *ImageId) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for CarId
+
+	// no validation rules for Kind
+
+	// no validation rules for MimeType
+
+	// no validation rules for Visibility
+
+	// no validation rules for Url
+
+	// no validation rules for Variants
+
+	// no validation rules for Position
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Checksum
+
+	// no validation rules for UploadedAt
+
 	if len(errors) > 0 {
-		return ImageIdMultiError(errors)
+		return MediaItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImageIdMultiError is an error wrapping multiple validation errors returned
-// by ImageId.ValidateAll() if the designated constraints aren't met.
-type ImageIdMultiError []error
+// MediaItemMultiError is an error wrapping multiple validation errors returned
+// by MediaItem.ValidateAll() if the designated constraints aren't met.
+type MediaItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImageIdMultiError) Error() string {
+func (m MediaItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4648,11 +4827,11 @@ func (m ImageIdMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImageIdMultiError) AllErrors() []error { return m }
+func (m MediaItemMultiError) AllErrors() []error { return m }
 
-// ImageIdValidationError is the validation error returned by ImageId.Validate
-// if the designated constraints aren't met.
-type ImageIdValidationError struct {
+// MediaItemValidationError is the validation error returned by
+// MediaItem.Validate if the designated constraints aren't met.
+type MediaItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4660,22 +4839,22 @@ type ImageIdValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImageIdValidationError) Field() string { return e.field }
+func (e MediaItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImageIdValidationError) Reason() string { return e.reason }
+func (e MediaItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImageIdValidationError) Cause() error { return e.cause }
+func (e MediaItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImageIdValidationError) Key() bool { return e.key }
+func (e MediaItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImageIdValidationError) ErrorName() string { return "ImageIdValidationError" }
+func (e MediaItemValidationError) ErrorName() string { return "MediaItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ImageIdValidationError) Error() string {
+func (e MediaItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4687,14 +4866,14 @@ func (e ImageIdValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImageId.%s: %s%s",
+		"invalid %sMediaItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImageIdValidationError{}
+var _ error = MediaItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -4702,24 +4881,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImageIdValidationError{}
+} = MediaItemValidationError{}
 
-// Validate checks the field values on CreateCommentRequest with the rules
+// Validate checks the field values on AddCarMediaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateCommentRequest) Validate() error {
+func (m *AddCarMediaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateCommentRequest with the rules
+// ValidateAll checks the field values on AddCarMediaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateCommentRequestMultiError, or nil if none found.
-func (m *CreateCommentRequest) ValidateAll() error {
+// AddCarMediaRequestMultiError, or nil if none found.
+func (m *AddCarMediaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateCommentRequest) validate(all bool) error {
+func (m *AddCarMediaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4728,22 +4907,32 @@ func (m *CreateCommentRequest) validate(all bool) error {
 
 	// no validation rules for CarId
 
-	// no validation rules for Content
+	// no validation rules for Kind
+
+	// no validation rules for Filename
+
+	// no validation rules for MimeType
+
+	// no validation rules for Visibility
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Checksum
 
 	if len(errors) > 0 {
-		return CreateCommentRequestMultiError(errors)
+		return AddCarMediaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateCommentRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateCommentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateCommentRequestMultiError []error
+// AddCarMediaRequestMultiError is an error wrapping multiple validation errors
+// returned by AddCarMediaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddCarMediaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateCommentRequestMultiError) Error() string {
+func (m AddCarMediaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4752,11 +4941,11 @@ func (m CreateCommentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateCommentRequestMultiError) AllErrors() []error { return m }
+func (m AddCarMediaRequestMultiError) AllErrors() []error { return m }
 
-// CreateCommentRequestValidationError is the validation error returned by
-// CreateCommentRequest.Validate if the designated constraints aren't met.
-type CreateCommentRequestValidationError struct {
+// AddCarMediaRequestValidationError is the validation error returned by
+// AddCarMediaRequest.Validate if the designated constraints aren't met.
+type AddCarMediaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4764,24 +4953,24 @@ type CreateCommentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCommentRequestValidationError) Field() string { return e.field }
+func (e AddCarMediaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCommentRequestValidationError) Reason() string { return e.reason }
+func (e AddCarMediaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCommentRequestValidationError) Cause() error { return e.cause }
+func (e AddCarMediaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCommentRequestValidationError) Key() bool { return e.key }
+func (e AddCarMediaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCommentRequestValidationError) ErrorName() string {
-	return "CreateCommentRequestValidationError"
+func (e AddCarMediaRequestValidationError) ErrorName() string {
+	return "AddCarMediaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCommentRequestValidationError) Error() string {
+func (e AddCarMediaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4793,14 +4982,14 @@ func (e CreateCommentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCommentRequest.%s: %s%s",
+		"invalid %sAddCarMediaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCommentRequestValidationError{}
+var _ error = AddCarMediaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4808,54 +4997,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCommentRequestValidationError{}
+} = AddCarMediaRequestValidationError{}
 
-// Validate checks the field values on Comment with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Comment) Validate() error {
+// Validate checks the field values on ListCarMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCarMediaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Comment with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CommentMultiError, or nil if none found.
-func (m *Comment) ValidateAll() error {
+// ValidateAll checks the field values on ListCarMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCarMediaRequestMultiError, or nil if none found.
+func (m *ListCarMediaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Comment) validate(all bool) error {
+func (m *ListCarMediaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for UserId
-
 	// no validation rules for CarId
 
-	// no validation rules for Content
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for UpdatedAt
+	// no validation rules for Kind
 
 	if len(errors) > 0 {
-		return CommentMultiError(errors)
+		return ListCarMediaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentMultiError is an error wrapping multiple validation errors returned
-// by Comment.ValidateAll() if the designated constraints aren't met.
-type CommentMultiError []error
+// ListCarMediaRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCarMediaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCarMediaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentMultiError) Error() string {
+func (m ListCarMediaRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4864,11 +5047,11 @@ func (m CommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentMultiError) AllErrors() []error { return m }
+func (m ListCarMediaRequestMultiError) AllErrors() []error { return m }
 
-// CommentValidationError is the validation error returned by Comment.Validate
-// if the designated constraints aren't met.
-type CommentValidationError struct {
+// ListCarMediaRequestValidationError is the validation error returned by
+// ListCarMediaRequest.Validate if the designated constraints aren't met.
+type ListCarMediaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4876,22 +5059,24 @@ type CommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentValidationError) Field() string { return e.field }
+func (e ListCarMediaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentValidationError) Reason() string { return e.reason }
+func (e ListCarMediaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentValidationError) Cause() error { return e.cause }
+func (e ListCarMediaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentValidationError) Key() bool { return e.key }
+func (e ListCarMediaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+func (e ListCarMediaRequestValidationError) ErrorName() string {
+	return "ListCarMediaRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommentValidationError) Error() string {
+func (e ListCarMediaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4903,14 +5088,14 @@ func (e CommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sComment.%s: %s%s",
+		"invalid %sListCarMediaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentValidationError{}
+var _ error = ListCarMediaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4918,47 +5103,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentValidationError{}
+} = ListCarMediaRequestValidationError{}
 
-// Validate checks the field values on ListCommentsResponse with the rules
+// Validate checks the field values on ListCarMediaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCommentsResponse) Validate() error {
+func (m *ListCarMediaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCommentsResponse with the rules
+// ValidateAll checks the field values on ListCarMediaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCommentsResponseMultiError, or nil if none found.
-func (m *ListCommentsResponse) ValidateAll() error {
+// ListCarMediaResponseMultiError, or nil if none found.
+func (m *ListCarMediaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCommentsResponse) validate(all bool) error {
+func (m *ListCarMediaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetComments() {
+	for idx, item := range m.GetItems() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCommentsResponseValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
+					errors = append(errors, ListCarMediaResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCommentsResponseValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
+					errors = append(errors, ListCarMediaResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4966,8 +5151,8 @@ func (m *ListCommentsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListCommentsResponseValidationError{
-					field:  fmt.Sprintf("Comments[%v]", idx),
+				return ListCarMediaResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4977,19 +5162,19 @@ func (m *ListCommentsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListCommentsResponseMultiError(errors)
+		return ListCarMediaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCommentsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListCommentsResponse.ValidateAll() if the designated
+// ListCarMediaResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCarMediaResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListCommentsResponseMultiError []error
+type ListCarMediaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCommentsResponseMultiError) Error() string {
+func (m ListCarMediaResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4998,11 +5183,11 @@ func (m ListCommentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCommentsResponseMultiError) AllErrors() []error { return m }
+func (m ListCarMediaResponseMultiError) AllErrors() []error { return m }
 
-// ListCommentsResponseValidationError is the validation error returned by
-// ListCommentsResponse.Validate if the designated constraints aren't met.
-type ListCommentsResponseValidationError struct {
+// ListCarMediaResponseValidationError is the validation error returned by
+// ListCarMediaResponse.Validate if the designated constraints aren't met.
+type ListCarMediaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5010,24 +5195,24 @@ type ListCommentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCommentsResponseValidationError) Field() string { return e.field }
+func (e ListCarMediaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCommentsResponseValidationError) Reason() string { return e.reason }
+func (e ListCarMediaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCommentsResponseValidationError) Cause() error { return e.cause }
+func (e ListCarMediaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCommentsResponseValidationError) Key() bool { return e.key }
+func (e ListCarMediaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCommentsResponseValidationError) ErrorName() string {
-	return "ListCommentsResponseValidationError"
+func (e ListCarMediaResponseValidationError) ErrorName() string {
+	return "ListCarMediaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCommentsResponseValidationError) Error() string {
+func (e ListCarMediaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5039,14 +5224,14 @@ func (e ListCommentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCommentsResponse.%s: %s%s",
+		"invalid %sListCarMediaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCommentsResponseValidationError{}
+var _ error = ListCarMediaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5054,48 +5239,7530 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCommentsResponseValidationError{}
+} = ListCarMediaResponseValidationError{}
 
-// Validate checks the field values on UpdateCommentRequest with the rules
+// Validate checks the field values on MediaId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaId with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MediaIdMultiError, or nil if none found.
+func (m *MediaId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return MediaIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaIdMultiError is an error wrapping multiple validation errors returned
+// by MediaId.ValidateAll() if the designated constraints aren't met.
+type MediaIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaIdMultiError) AllErrors() []error { return m }
+
+// MediaIdValidationError is the validation error returned by MediaId.Validate
+// if the designated constraints aren't met.
+type MediaIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaIdValidationError) ErrorName() string { return "MediaIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaIdValidationError{}
+
+// Validate checks the field values on ListImageMatchesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImageMatchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImageMatchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImageMatchesRequestMultiError, or nil if none found.
+func (m *ListImageMatchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImageMatchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListImageMatchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImageMatchesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListImageMatchesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListImageMatchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImageMatchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImageMatchesRequestMultiError) AllErrors() []error { return m }
+
+// ListImageMatchesRequestValidationError is the validation error returned by
+// ListImageMatchesRequest.Validate if the designated constraints aren't met.
+type ListImageMatchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImageMatchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImageMatchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImageMatchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImageMatchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImageMatchesRequestValidationError) ErrorName() string {
+	return "ListImageMatchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImageMatchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImageMatchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImageMatchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImageMatchesRequestValidationError{}
+
+// Validate checks the field values on ImageMatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageMatchMultiError, or
+// nil if none found.
+func (m *ImageMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ImageId
+
+	// no validation rules for CarId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Url
+
+	// no validation rules for MatchedImageId
+
+	// no validation rules for MatchedCarId
+
+	// no validation rules for MatchedOwnerId
+
+	// no validation rules for MatchedUrl
+
+	// no validation rules for Distance
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ImageMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageMatchMultiError is an error wrapping multiple validation errors
+// returned by ImageMatch.ValidateAll() if the designated constraints aren't met.
+type ImageMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageMatchMultiError) AllErrors() []error { return m }
+
+// ImageMatchValidationError is the validation error returned by
+// ImageMatch.Validate if the designated constraints aren't met.
+type ImageMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageMatchValidationError) ErrorName() string { return "ImageMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageMatchValidationError{}
+
+// Validate checks the field values on ListImageMatchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImageMatchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImageMatchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImageMatchesResponseMultiError, or nil if none found.
+func (m *ListImageMatchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImageMatchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListImageMatchesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListImageMatchesResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListImageMatchesResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListImageMatchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImageMatchesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListImageMatchesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListImageMatchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImageMatchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImageMatchesResponseMultiError) AllErrors() []error { return m }
+
+// ListImageMatchesResponseValidationError is the validation error returned by
+// ListImageMatchesResponse.Validate if the designated constraints aren't met.
+type ListImageMatchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImageMatchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImageMatchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImageMatchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImageMatchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImageMatchesResponseValidationError) ErrorName() string {
+	return "ListImageMatchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImageMatchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImageMatchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImageMatchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImageMatchesResponseValidationError{}
+
+// Validate checks the field values on ImageId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageId with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ImageIdMultiError, or nil if none found.
+func (m *ImageId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ImageIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageIdMultiError is an error wrapping multiple validation errors returned
+// by ImageId.ValidateAll() if the designated constraints aren't met.
+type ImageIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageIdMultiError) AllErrors() []error { return m }
+
+// ImageIdValidationError is the validation error returned by ImageId.Validate
+// if the designated constraints aren't met.
+type ImageIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageIdValidationError) ErrorName() string { return "ImageIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageIdValidationError{}
+
+// Validate checks the field values on CreateImageUploadURLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateImageUploadURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateImageUploadURLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateImageUploadURLRequestMultiError, or nil if none found.
+func (m *CreateImageUploadURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateImageUploadURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	if len(errors) > 0 {
+		return CreateImageUploadURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateImageUploadURLRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateImageUploadURLRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateImageUploadURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateImageUploadURLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateImageUploadURLRequestMultiError) AllErrors() []error { return m }
+
+// CreateImageUploadURLRequestValidationError is the validation error returned
+// by CreateImageUploadURLRequest.Validate if the designated constraints
+// aren't met.
+type CreateImageUploadURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateImageUploadURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateImageUploadURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateImageUploadURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateImageUploadURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateImageUploadURLRequestValidationError) ErrorName() string {
+	return "CreateImageUploadURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateImageUploadURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateImageUploadURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateImageUploadURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateImageUploadURLRequestValidationError{}
+
+// Validate checks the field values on SignedURL with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SignedURL) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignedURL with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SignedURLMultiError, or nil
+// if none found.
+func (m *SignedURL) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignedURL) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Method
+
+	// no validation rules for Key
+
+	// no validation rules for ExpiresAt
+
+	if len(errors) > 0 {
+		return SignedURLMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignedURLMultiError is an error wrapping multiple validation errors returned
+// by SignedURL.ValidateAll() if the designated constraints aren't met.
+type SignedURLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignedURLMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignedURLMultiError) AllErrors() []error { return m }
+
+// SignedURLValidationError is the validation error returned by
+// SignedURL.Validate if the designated constraints aren't met.
+type SignedURLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignedURLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignedURLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignedURLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignedURLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignedURLValidationError) ErrorName() string { return "SignedURLValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignedURLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignedURL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignedURLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignedURLValidationError{}
+
+// Validate checks the field values on ReorderCarImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderCarImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderCarImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderCarImagesRequestMultiError, or nil if none found.
+func (m *ReorderCarImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderCarImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for CoverImageId
+
+	if len(errors) > 0 {
+		return ReorderCarImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderCarImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderCarImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReorderCarImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderCarImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderCarImagesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderCarImagesRequestValidationError is the validation error returned by
+// ReorderCarImagesRequest.Validate if the designated constraints aren't met.
+type ReorderCarImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderCarImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderCarImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderCarImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderCarImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderCarImagesRequestValidationError) ErrorName() string {
+	return "ReorderCarImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderCarImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderCarImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderCarImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderCarImagesRequestValidationError{}
+
+// Validate checks the field values on CreateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCommentRequestMultiError, or nil if none found.
+func (m *CreateCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for Content
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return CreateCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCommentRequestMultiError) AllErrors() []error { return m }
+
+// CreateCommentRequestValidationError is the validation error returned by
+// CreateCommentRequest.Validate if the designated constraints aren't met.
+type CreateCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCommentRequestValidationError) ErrorName() string {
+	return "CreateCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCommentRequestValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for CarId
+
+	// no validation rules for Content
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for ModerationStatus
+
+	// no validation rules for ParentId
+
+	// no validation rules for Depth
+
+	// no validation rules for IsSeller
+
+	// no validation rules for LikeCount
+
+	// no validation rules for Reactions
+
+	// no validation rules for MyReaction
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsRequestMultiError, or nil if none found.
+func (m *ListCommentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for Sort
+
+	// no validation rules for Cursor
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListCommentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsRequestMultiError) AllErrors() []error { return m }
+
+// ListCommentsRequestValidationError is the validation error returned by
+// ListCommentsRequest.Validate if the designated constraints aren't met.
+type ListCommentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsRequestValidationError) ErrorName() string {
+	return "ListCommentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsRequestValidationError{}
+
+// Validate checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCommentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCommentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCommentsResponseMultiError, or nil if none found.
+func (m *ListCommentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCommentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCommentsResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCommentsResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListCommentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCommentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCommentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCommentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCommentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCommentsResponseMultiError) AllErrors() []error { return m }
+
+// ListCommentsResponseValidationError is the validation error returned by
+// ListCommentsResponse.Validate if the designated constraints aren't met.
+type ListCommentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCommentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCommentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCommentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCommentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCommentsResponseValidationError) ErrorName() string {
+	return "ListCommentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCommentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCommentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCommentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCommentsResponseValidationError{}
+
+// Validate checks the field values on UpdateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCommentRequestMultiError, or nil if none found.
+func (m *UpdateCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UpdateCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCommentRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCommentRequestValidationError is the validation error returned by
+// UpdateCommentRequest.Validate if the designated constraints aren't met.
+type UpdateCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCommentRequestValidationError) ErrorName() string {
+	return "UpdateCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCommentRequestValidationError{}
+
+// Validate checks the field values on CommentId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommentId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentId with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentIdMultiError, or nil
+// if none found.
+func (m *CommentId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CommentIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentIdMultiError is an error wrapping multiple validation errors returned
+// by CommentId.ValidateAll() if the designated constraints aren't met.
+type CommentIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentIdMultiError) AllErrors() []error { return m }
+
+// CommentIdValidationError is the validation error returned by
+// CommentId.Validate if the designated constraints aren't met.
+type CommentIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentIdValidationError) ErrorName() string { return "CommentIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentIdValidationError{}
+
+// Validate checks the field values on ReactToCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReactToCommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactToCommentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReactToCommentRequestMultiError, or nil if none found.
+func (m *ReactToCommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactToCommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reaction
+
+	if len(errors) > 0 {
+		return ReactToCommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactToCommentRequestMultiError is an error wrapping multiple validation
+// errors returned by ReactToCommentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReactToCommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactToCommentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactToCommentRequestMultiError) AllErrors() []error { return m }
+
+// ReactToCommentRequestValidationError is the validation error returned by
+// ReactToCommentRequest.Validate if the designated constraints aren't met.
+type ReactToCommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactToCommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactToCommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactToCommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactToCommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactToCommentRequestValidationError) ErrorName() string {
+	return "ReactToCommentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReactToCommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactToCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactToCommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactToCommentRequestValidationError{}
+
+// Validate checks the field values on CommentReactions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CommentReactions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentReactions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentReactionsMultiError, or nil if none found.
+func (m *CommentReactions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentReactions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommentId
+
+	// no validation rules for Reactions
+
+	// no validation rules for LikeCount
+
+	// no validation rules for MyReaction
+
+	if len(errors) > 0 {
+		return CommentReactionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentReactionsMultiError is an error wrapping multiple validation errors
+// returned by CommentReactions.ValidateAll() if the designated constraints
+// aren't met.
+type CommentReactionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentReactionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentReactionsMultiError) AllErrors() []error { return m }
+
+// CommentReactionsValidationError is the validation error returned by
+// CommentReactions.Validate if the designated constraints aren't met.
+type CommentReactionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentReactionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentReactionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentReactionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentReactionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentReactionsValidationError) ErrorName() string { return "CommentReactionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentReactionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentReactions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentReactionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentReactionsValidationError{}
+
+// Validate checks the field values on BoolCheck with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BoolCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BoolCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BoolCheckMultiError, or nil
+// if none found.
+func (m *BoolCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BoolCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return BoolCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoolCheckMultiError is an error wrapping multiple validation errors returned
+// by BoolCheck.ValidateAll() if the designated constraints aren't met.
+type BoolCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoolCheckMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoolCheckMultiError) AllErrors() []error { return m }
+
+// BoolCheckValidationError is the validation error returned by
+// BoolCheck.Validate if the designated constraints aren't met.
+type BoolCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoolCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoolCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoolCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoolCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoolCheckValidationError) ErrorName() string { return "BoolCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoolCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBoolCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoolCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoolCheckValidationError{}
+
+// Validate checks the field values on BoolCheckComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BoolCheckComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BoolCheckComment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BoolCheckCommentMultiError, or nil if none found.
+func (m *BoolCheckComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BoolCheckComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CommentId
+
+	if len(errors) > 0 {
+		return BoolCheckCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// BoolCheckCommentMultiError is an error wrapping multiple validation errors
+// returned by BoolCheckComment.ValidateAll() if the designated constraints
+// aren't met.
+type BoolCheckCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BoolCheckCommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BoolCheckCommentMultiError) AllErrors() []error { return m }
+
+// BoolCheckCommentValidationError is the validation error returned by
+// BoolCheckComment.Validate if the designated constraints aren't met.
+type BoolCheckCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BoolCheckCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BoolCheckCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BoolCheckCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BoolCheckCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BoolCheckCommentValidationError) ErrorName() string { return "BoolCheckCommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BoolCheckCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBoolCheckComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BoolCheckCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BoolCheckCommentValidationError{}
+
+// Validate checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Review) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReviewMultiError, or nil if none found.
+func (m *Review) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Review) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for SellerId
+
+	// no validation rules for Rating
+
+	// no validation rules for Content
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return ReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewMultiError is an error wrapping multiple validation errors returned by
+// Review.ValidateAll() if the designated constraints aren't met.
+type ReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewMultiError) AllErrors() []error { return m }
+
+// ReviewValidationError is the validation error returned by Review.Validate if
+// the designated constraints aren't met.
+type ReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewValidationError) ErrorName() string { return "ReviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for Rating
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return CreateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewRequestValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Rating
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+// Validate checks the field values on ReviewId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewId with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewIdMultiError, or nil
+// if none found.
+func (m *ReviewId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReviewIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewIdMultiError is an error wrapping multiple validation errors returned
+// by ReviewId.ValidateAll() if the designated constraints aren't met.
+type ReviewIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewIdMultiError) AllErrors() []error { return m }
+
+// ReviewIdValidationError is the validation error returned by
+// ReviewId.Validate if the designated constraints aren't met.
+type ReviewIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewIdValidationError) ErrorName() string { return "ReviewIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewIdValidationError{}
+
+// Validate checks the field values on ListReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewsRequestMultiError, or nil if none found.
+func (m *ListReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReviewsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewsRequestValidationError is the validation error returned by
+// ListReviewsRequest.Validate if the designated constraints aren't met.
+type ListReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewsRequestValidationError) ErrorName() string {
+	return "ListReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewsRequestValidationError{}
+
+// Validate checks the field values on ListReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewsResponseMultiError, or nil if none found.
+func (m *ListReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RatingAvg
+
+	// no validation rules for RatingCount
+
+	if len(errors) > 0 {
+		return ListReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ListReviewsResponseValidationError is the validation error returned by
+// ListReviewsResponse.Validate if the designated constraints aren't met.
+type ListReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewsResponseValidationError) ErrorName() string {
+	return "ListReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewsResponseValidationError{}
+
+// Validate checks the field values on SellerSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SellerSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SellerSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SellerSummaryRequestMultiError, or nil if none found.
+func (m *SellerSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SellerSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SellerId
+
+	if len(errors) > 0 {
+		return SellerSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SellerSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by SellerSummaryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SellerSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SellerSummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SellerSummaryRequestMultiError) AllErrors() []error { return m }
+
+// SellerSummaryRequestValidationError is the validation error returned by
+// SellerSummaryRequest.Validate if the designated constraints aren't met.
+type SellerSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SellerSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SellerSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SellerSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SellerSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SellerSummaryRequestValidationError) ErrorName() string {
+	return "SellerSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SellerSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSellerSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SellerSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SellerSummaryRequestValidationError{}
+
+// Validate checks the field values on SellerSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SellerSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SellerSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SellerSummaryMultiError, or
+// nil if none found.
+func (m *SellerSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SellerSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SellerId
+
+	// no validation rules for RatingAvg
+
+	// no validation rules for RatingCount
+
+	// no validation rules for ActiveListings
+
+	if len(errors) > 0 {
+		return SellerSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// SellerSummaryMultiError is an error wrapping multiple validation errors
+// returned by SellerSummary.ValidateAll() if the designated constraints
+// aren't met.
+type SellerSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SellerSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SellerSummaryMultiError) AllErrors() []error { return m }
+
+// SellerSummaryValidationError is the validation error returned by
+// SellerSummary.Validate if the designated constraints aren't met.
+type SellerSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SellerSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SellerSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SellerSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SellerSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SellerSummaryValidationError) ErrorName() string { return "SellerSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SellerSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSellerSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SellerSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SellerSummaryValidationError{}
+
+// Validate checks the field values on GetAuditTrailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditTrailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditTrailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditTrailRequestMultiError, or nil if none found.
+func (m *GetAuditTrailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditTrailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	if len(errors) > 0 {
+		return GetAuditTrailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditTrailRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAuditTrailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAuditTrailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditTrailRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditTrailRequestMultiError) AllErrors() []error { return m }
+
+// GetAuditTrailRequestValidationError is the validation error returned by
+// GetAuditTrailRequest.Validate if the designated constraints aren't met.
+type GetAuditTrailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditTrailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditTrailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditTrailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditTrailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditTrailRequestValidationError) ErrorName() string {
+	return "GetAuditTrailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAuditTrailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditTrailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditTrailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditTrailRequestValidationError{}
+
+// Validate checks the field values on AuditEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntryMultiError, or
+// nil if none found.
+func (m *AuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActorId
+
+	// no validation rules for Rpc
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	// no validation rules for ClientIp
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditEntry.ValidateAll() if the designated constraints aren't met.
+type AuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntryMultiError) AllErrors() []error { return m }
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.Validate if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string { return "AuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
+
+// Validate checks the field values on ListAuditEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEntriesResponseMultiError, or nil if none found.
+func (m *ListAuditEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEntriesResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAuditEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEntriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEntriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEntriesResponseValidationError is the validation error returned by
+// ListAuditEntriesResponse.Validate if the designated constraints aren't met.
+type ListAuditEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEntriesResponseValidationError) ErrorName() string {
+	return "ListAuditEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEntriesResponseValidationError{}
+
+// Validate checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueRequestMultiError, or nil if none found.
+func (m *ListModerationQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListModerationQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueRequestMultiError is an error wrapping multiple
+// validation errors returned by ListModerationQueueRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueRequestMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueRequestValidationError is the validation error returned
+// by ListModerationQueueRequest.Validate if the designated constraints aren't met.
+type ListModerationQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueRequestValidationError) ErrorName() string {
+	return "ListModerationQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueRequestValidationError{}
+
+// Validate checks the field values on ModerationItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModerationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModerationItemMultiError,
+// or nil if none found.
+func (m *ModerationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ModerationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerationItemMultiError is an error wrapping multiple validation errors
+// returned by ModerationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ModerationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerationItemMultiError) AllErrors() []error { return m }
+
+// ModerationItemValidationError is the validation error returned by
+// ModerationItem.Validate if the designated constraints aren't met.
+type ModerationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerationItemValidationError) ErrorName() string { return "ModerationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModerationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerationItemValidationError{}
+
+// Validate checks the field values on ListModerationItemsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationItemsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationItemsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationItemsResponseMultiError, or nil if none found.
+func (m *ListModerationItemsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationItemsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModerationItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModerationItemsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModerationItemsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModerationItemsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationItemsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListModerationItemsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationItemsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationItemsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationItemsResponseMultiError) AllErrors() []error { return m }
+
+// ListModerationItemsResponseValidationError is the validation error returned
+// by ListModerationItemsResponse.Validate if the designated constraints
+// aren't met.
+type ListModerationItemsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationItemsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationItemsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationItemsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationItemsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationItemsResponseValidationError) ErrorName() string {
+	return "ListModerationItemsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationItemsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationItemsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationItemsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationItemsResponseValidationError{}
+
+// Validate checks the field values on ModerationDecisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerationDecisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerationDecisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerationDecisionRequestMultiError, or nil if none found.
+func (m *ModerationDecisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerationDecisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ModerationDecisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerationDecisionRequestMultiError is an error wrapping multiple validation
+// errors returned by ModerationDecisionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ModerationDecisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerationDecisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerationDecisionRequestMultiError) AllErrors() []error { return m }
+
+// ModerationDecisionRequestValidationError is the validation error returned by
+// ModerationDecisionRequest.Validate if the designated constraints aren't met.
+type ModerationDecisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerationDecisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerationDecisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerationDecisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerationDecisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerationDecisionRequestValidationError) ErrorName() string {
+	return "ModerationDecisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerationDecisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerationDecisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerationDecisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerationDecisionRequestValidationError{}
+
+// Validate checks the field values on ReportContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportContentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportContentRequestMultiError, or nil if none found.
+func (m *ReportContentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportContentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for Reason
+
+	// no validation rules for Details
+
+	if len(errors) > 0 {
+		return ReportContentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportContentRequestMultiError is an error wrapping multiple validation
+// errors returned by ReportContentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReportContentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportContentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportContentRequestMultiError) AllErrors() []error { return m }
+
+// ReportContentRequestValidationError is the validation error returned by
+// ReportContentRequest.Validate if the designated constraints aren't met.
+type ReportContentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportContentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportContentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportContentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportContentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportContentRequestValidationError) ErrorName() string {
+	return "ReportContentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportContentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportContentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportContentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportContentRequestValidationError{}
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for ReporterId
+
+	// no validation rules for Reason
+
+	// no validation rules for Details
+
+	// no validation rules for Status
+
+	// no validation rules for Outcome
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for ResolvedAt
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for EntityType
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsRequestValidationError{}
+
+// Validate checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsResponseMultiError, or nil if none found.
+func (m *ListReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportsResponseValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsResponseMultiError) AllErrors() []error { return m }
+
+// ListReportsResponseValidationError is the validation error returned by
+// ListReportsResponse.Validate if the designated constraints aren't met.
+type ListReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsResponseValidationError) ErrorName() string {
+	return "ListReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsResponseValidationError{}
+
+// Validate checks the field values on TriageReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriageReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriageReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriageReportRequestMultiError, or nil if none found.
+func (m *TriageReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriageReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return TriageReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriageReportRequestMultiError is an error wrapping multiple validation
+// errors returned by TriageReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriageReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriageReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriageReportRequestMultiError) AllErrors() []error { return m }
+
+// TriageReportRequestValidationError is the validation error returned by
+// TriageReportRequest.Validate if the designated constraints aren't met.
+type TriageReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriageReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriageReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriageReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriageReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriageReportRequestValidationError) ErrorName() string {
+	return "TriageReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriageReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriageReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriageReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriageReportRequestValidationError{}
+
+// Validate checks the field values on ResolveReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveReportRequestMultiError, or nil if none found.
+func (m *ResolveReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Outcome
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return ResolveReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveReportRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveReportRequestMultiError) AllErrors() []error { return m }
+
+// ResolveReportRequestValidationError is the validation error returned by
+// ResolveReportRequest.Validate if the designated constraints aren't met.
+type ResolveReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveReportRequestValidationError) ErrorName() string {
+	return "ResolveReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveReportRequestValidationError{}
+
+// Validate checks the field values on EstimateCarPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EstimateCarPriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EstimateCarPriceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EstimateCarPriceRequestMultiError, or nil if none found.
+func (m *EstimateCarPriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EstimateCarPriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for Make
+
+	// no validation rules for Model
+
+	// no validation rules for Year
+
+	// no validation rules for Mileage
+
+	// no validation rules for Location
+
+	if len(errors) > 0 {
+		return EstimateCarPriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EstimateCarPriceRequestMultiError is an error wrapping multiple validation
+// errors returned by EstimateCarPriceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EstimateCarPriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EstimateCarPriceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EstimateCarPriceRequestMultiError) AllErrors() []error { return m }
+
+// EstimateCarPriceRequestValidationError is the validation error returned by
+// EstimateCarPriceRequest.Validate if the designated constraints aren't met.
+type EstimateCarPriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EstimateCarPriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EstimateCarPriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EstimateCarPriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EstimateCarPriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EstimateCarPriceRequestValidationError) ErrorName() string {
+	return "EstimateCarPriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EstimateCarPriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEstimateCarPriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EstimateCarPriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EstimateCarPriceRequestValidationError{}
+
+// Validate checks the field values on PriceEstimate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PriceEstimate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PriceEstimate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PriceEstimateMultiError, or
+// nil if none found.
+func (m *PriceEstimate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PriceEstimate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LowPrice
+
+	// no validation rules for MedianPrice
+
+	// no validation rules for HighPrice
+
+	// no validation rules for Comparables
+
+	// no validation rules for LocationMatched
+
+	// no validation rules for Sufficient
+
+	// no validation rules for PriceBadge
+
+	if len(errors) > 0 {
+		return PriceEstimateMultiError(errors)
+	}
+
+	return nil
+}
+
+// PriceEstimateMultiError is an error wrapping multiple validation errors
+// returned by PriceEstimate.ValidateAll() if the designated constraints
+// aren't met.
+type PriceEstimateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PriceEstimateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PriceEstimateMultiError) AllErrors() []error { return m }
+
+// PriceEstimateValidationError is the validation error returned by
+// PriceEstimate.Validate if the designated constraints aren't met.
+type PriceEstimateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PriceEstimateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PriceEstimateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PriceEstimateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PriceEstimateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PriceEstimateValidationError) ErrorName() string { return "PriceEstimateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PriceEstimateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPriceEstimate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PriceEstimateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PriceEstimateValidationError{}
+
+// Validate checks the field values on CatalogEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CatalogEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CatalogEntryRequestMultiError, or nil if none found.
+func (m *CatalogEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Make
+
+	// no validation rules for Model
+
+	// no validation rules for Name
+
+	// no validation rules for YearFrom
+
+	// no validation rules for YearTo
+
+	if len(errors) > 0 {
+		return CatalogEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by CatalogEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CatalogEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogEntryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogEntryRequestMultiError) AllErrors() []error { return m }
+
+// CatalogEntryRequestValidationError is the validation error returned by
+// CatalogEntryRequest.Validate if the designated constraints aren't met.
+type CatalogEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogEntryRequestValidationError) ErrorName() string {
+	return "CatalogEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CatalogEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogEntryRequestValidationError{}
+
+// Validate checks the field values on CatalogEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CatalogEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CatalogEntryMultiError, or
+// nil if none found.
+func (m *CatalogEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	// no validation rules for Make
+
+	// no validation rules for Model
+
+	// no validation rules for YearFrom
+
+	// no validation rules for YearTo
+
+	if len(errors) > 0 {
+		return CatalogEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogEntryMultiError is an error wrapping multiple validation errors
+// returned by CatalogEntry.ValidateAll() if the designated constraints aren't met.
+type CatalogEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogEntryMultiError) AllErrors() []error { return m }
+
+// CatalogEntryValidationError is the validation error returned by
+// CatalogEntry.Validate if the designated constraints aren't met.
+type CatalogEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogEntryValidationError) ErrorName() string { return "CatalogEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogEntryValidationError{}
+
+// Validate checks the field values on DeleteCatalogEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCatalogEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCatalogEntryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCatalogEntryRequestMultiError, or nil if none found.
+func (m *DeleteCatalogEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCatalogEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCatalogEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCatalogEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCatalogEntryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteCatalogEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCatalogEntryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCatalogEntryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCatalogEntryRequestValidationError is the validation error returned by
+// DeleteCatalogEntryRequest.Validate if the designated constraints aren't met.
+type DeleteCatalogEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCatalogEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCatalogEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCatalogEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCatalogEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCatalogEntryRequestValidationError) ErrorName() string {
+	return "DeleteCatalogEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCatalogEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCatalogEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCatalogEntryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCatalogEntryRequestValidationError{}
+
+// Validate checks the field values on AutocompleteMakeModelRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AutocompleteMakeModelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutocompleteMakeModelRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AutocompleteMakeModelRequestMultiError, or nil if none found.
+func (m *AutocompleteMakeModelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutocompleteMakeModelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Make
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return AutocompleteMakeModelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutocompleteMakeModelRequestMultiError is an error wrapping multiple
+// validation errors returned by AutocompleteMakeModelRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AutocompleteMakeModelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutocompleteMakeModelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutocompleteMakeModelRequestMultiError) AllErrors() []error { return m }
+
+// AutocompleteMakeModelRequestValidationError is the validation error returned
+// by AutocompleteMakeModelRequest.Validate if the designated constraints
+// aren't met.
+type AutocompleteMakeModelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutocompleteMakeModelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutocompleteMakeModelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutocompleteMakeModelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutocompleteMakeModelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutocompleteMakeModelRequestValidationError) ErrorName() string {
+	return "AutocompleteMakeModelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutocompleteMakeModelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutocompleteMakeModelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutocompleteMakeModelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutocompleteMakeModelRequestValidationError{}
+
+// Validate checks the field values on MakeModelSuggestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MakeModelSuggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MakeModelSuggestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MakeModelSuggestionMultiError, or nil if none found.
+func (m *MakeModelSuggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MakeModelSuggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Make
+
+	// no validation rules for Model
+
+	if len(errors) > 0 {
+		return MakeModelSuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MakeModelSuggestionMultiError is an error wrapping multiple validation
+// errors returned by MakeModelSuggestion.ValidateAll() if the designated
+// constraints aren't met.
+type MakeModelSuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MakeModelSuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MakeModelSuggestionMultiError) AllErrors() []error { return m }
+
+// MakeModelSuggestionValidationError is the validation error returned by
+// MakeModelSuggestion.Validate if the designated constraints aren't met.
+type MakeModelSuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MakeModelSuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MakeModelSuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MakeModelSuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MakeModelSuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MakeModelSuggestionValidationError) ErrorName() string {
+	return "MakeModelSuggestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MakeModelSuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMakeModelSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MakeModelSuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MakeModelSuggestionValidationError{}
+
+// Validate checks the field values on AutocompleteMakeModelResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AutocompleteMakeModelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AutocompleteMakeModelResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AutocompleteMakeModelResponseMultiError, or nil if none found.
+func (m *AutocompleteMakeModelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AutocompleteMakeModelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AutocompleteMakeModelResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AutocompleteMakeModelResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AutocompleteMakeModelResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AutocompleteMakeModelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AutocompleteMakeModelResponseMultiError is an error wrapping multiple
+// validation errors returned by AutocompleteMakeModelResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AutocompleteMakeModelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AutocompleteMakeModelResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AutocompleteMakeModelResponseMultiError) AllErrors() []error { return m }
+
+// AutocompleteMakeModelResponseValidationError is the validation error
+// returned by AutocompleteMakeModelResponse.Validate if the designated
+// constraints aren't met.
+type AutocompleteMakeModelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AutocompleteMakeModelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AutocompleteMakeModelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AutocompleteMakeModelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AutocompleteMakeModelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AutocompleteMakeModelResponseValidationError) ErrorName() string {
+	return "AutocompleteMakeModelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AutocompleteMakeModelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAutocompleteMakeModelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AutocompleteMakeModelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AutocompleteMakeModelResponseValidationError{}
+
+// Validate checks the field values on SuggestSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestSearchRequestMultiError, or nil if none found.
+func (m *SuggestSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return SuggestSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by SuggestSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestSearchRequestMultiError) AllErrors() []error { return m }
+
+// SuggestSearchRequestValidationError is the validation error returned by
+// SuggestSearchRequest.Validate if the designated constraints aren't met.
+type SuggestSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestSearchRequestValidationError) ErrorName() string {
+	return "SuggestSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestSearchRequestValidationError{}
+
+// Validate checks the field values on SearchSuggestion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchSuggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchSuggestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchSuggestionMultiError, or nil if none found.
+func (m *SearchSuggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchSuggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return SearchSuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchSuggestionMultiError is an error wrapping multiple validation errors
+// returned by SearchSuggestion.ValidateAll() if the designated constraints
+// aren't met.
+type SearchSuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchSuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchSuggestionMultiError) AllErrors() []error { return m }
+
+// SearchSuggestionValidationError is the validation error returned by
+// SearchSuggestion.Validate if the designated constraints aren't met.
+type SearchSuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchSuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchSuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchSuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchSuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchSuggestionValidationError) ErrorName() string { return "SearchSuggestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchSuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchSuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchSuggestionValidationError{}
+
+// Validate checks the field values on SuggestSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuggestSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestSearchResponseMultiError, or nil if none found.
+func (m *SuggestSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestSearchResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestSearchResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestSearchResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by SuggestSearchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuggestSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestSearchResponseMultiError) AllErrors() []error { return m }
+
+// SuggestSearchResponseValidationError is the validation error returned by
+// SuggestSearchResponse.Validate if the designated constraints aren't met.
+type SuggestSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestSearchResponseValidationError) ErrorName() string {
+	return "SuggestSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuggestSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestSearchResponseValidationError{}
+
+// Validate checks the field values on GetTrendingSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTrendingSearchesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTrendingSearchesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTrendingSearchesRequestMultiError, or nil if none found.
+func (m *GetTrendingSearchesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTrendingSearchesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetTrendingSearchesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTrendingSearchesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTrendingSearchesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTrendingSearchesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTrendingSearchesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTrendingSearchesRequestMultiError) AllErrors() []error { return m }
+
+// GetTrendingSearchesRequestValidationError is the validation error returned
+// by GetTrendingSearchesRequest.Validate if the designated constraints aren't met.
+type GetTrendingSearchesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTrendingSearchesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTrendingSearchesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTrendingSearchesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTrendingSearchesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTrendingSearchesRequestValidationError) ErrorName() string {
+	return "GetTrendingSearchesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTrendingSearchesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTrendingSearchesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTrendingSearchesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTrendingSearchesRequestValidationError{}
+
+// Validate checks the field values on TrendingSearch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendingSearch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingSearch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendingSearchMultiError,
+// or nil if none found.
+func (m *TrendingSearch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingSearch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Searches
+
+	if len(errors) > 0 {
+		return TrendingSearchMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingSearchMultiError is an error wrapping multiple validation errors
+// returned by TrendingSearch.ValidateAll() if the designated constraints
+// aren't met.
+type TrendingSearchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingSearchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingSearchMultiError) AllErrors() []error { return m }
+
+// TrendingSearchValidationError is the validation error returned by
+// TrendingSearch.Validate if the designated constraints aren't met.
+type TrendingSearchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingSearchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingSearchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingSearchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingSearchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingSearchValidationError) ErrorName() string { return "TrendingSearchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendingSearchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingSearch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingSearchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingSearchValidationError{}
+
+// Validate checks the field values on TrendingSearchesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TrendingSearchesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingSearchesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TrendingSearchesResponseMultiError, or nil if none found.
+func (m *TrendingSearchesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingSearchesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSearches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrendingSearchesResponseValidationError{
+						field:  fmt.Sprintf("Searches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrendingSearchesResponseValidationError{
+						field:  fmt.Sprintf("Searches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrendingSearchesResponseValidationError{
+					field:  fmt.Sprintf("Searches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TrendingSearchesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingSearchesResponseMultiError is an error wrapping multiple validation
+// errors returned by TrendingSearchesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TrendingSearchesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingSearchesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingSearchesResponseMultiError) AllErrors() []error { return m }
+
+// TrendingSearchesResponseValidationError is the validation error returned by
+// TrendingSearchesResponse.Validate if the designated constraints aren't met.
+type TrendingSearchesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingSearchesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingSearchesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingSearchesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingSearchesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingSearchesResponseValidationError) ErrorName() string {
+	return "TrendingSearchesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrendingSearchesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingSearchesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingSearchesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingSearchesResponseValidationError{}
+
+// Validate checks the field values on InitiateCarTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateCarTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateCarTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateCarTransferRequestMultiError, or nil if none found.
+func (m *InitiateCarTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateCarTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CarId
+
+	// no validation rules for ToUserId
+
+	if len(errors) > 0 {
+		return InitiateCarTransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateCarTransferRequestMultiError is an error wrapping multiple
+// validation errors returned by InitiateCarTransferRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InitiateCarTransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateCarTransferRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateCarTransferRequestMultiError) AllErrors() []error { return m }
+
+// InitiateCarTransferRequestValidationError is the validation error returned
+// by InitiateCarTransferRequest.Validate if the designated constraints aren't met.
+type InitiateCarTransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateCarTransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateCarTransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateCarTransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateCarTransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateCarTransferRequestValidationError) ErrorName() string {
+	return "InitiateCarTransferRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateCarTransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateCarTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateCarTransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateCarTransferRequestValidationError{}
+
+// Validate checks the field values on CarTransfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CarTransfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CarTransfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CarTransferMultiError, or
+// nil if none found.
+func (m *CarTransfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CarTransfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CarId
+
+	// no validation rules for FromUserId
+
+	// no validation rules for ToUserId
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for RespondedAt
+
+	if len(errors) > 0 {
+		return CarTransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// CarTransferMultiError is an error wrapping multiple validation errors
+// returned by CarTransfer.ValidateAll() if the designated constraints aren't met.
+type CarTransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CarTransferMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CarTransferMultiError) AllErrors() []error { return m }
+
+// CarTransferValidationError is the validation error returned by
+// CarTransfer.Validate if the designated constraints aren't met.
+type CarTransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CarTransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CarTransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CarTransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CarTransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CarTransferValidationError) ErrorName() string { return "CarTransferValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CarTransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCarTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CarTransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CarTransferValidationError{}
+
+// Validate checks the field values on CarTransferId with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CarTransferId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CarTransferId with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CarTransferIdMultiError, or
+// nil if none found.
+func (m *CarTransferId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CarTransferId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CarTransferIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// CarTransferIdMultiError is an error wrapping multiple validation errors
+// returned by CarTransferId.ValidateAll() if the designated constraints
+// aren't met.
+type CarTransferIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CarTransferIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CarTransferIdMultiError) AllErrors() []error { return m }
+
+// CarTransferIdValidationError is the validation error returned by
+// CarTransferId.Validate if the designated constraints aren't met.
+type CarTransferIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CarTransferIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CarTransferIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CarTransferIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CarTransferIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CarTransferIdValidationError) ErrorName() string { return "CarTransferIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CarTransferIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCarTransferId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CarTransferIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CarTransferIdValidationError{}
+
+// Validate checks the field values on ListCarTransfersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCarTransfersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCarTransfersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCarTransfersRequestMultiError, or nil if none found.
+func (m *ListCarTransfersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCarTransfersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ListCarTransfersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCarTransfersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCarTransfersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCarTransfersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCarTransfersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCarTransfersRequestMultiError) AllErrors() []error { return m }
+
+// ListCarTransfersRequestValidationError is the validation error returned by
+// ListCarTransfersRequest.Validate if the designated constraints aren't met.
+type ListCarTransfersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCarTransfersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCarTransfersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCarTransfersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCarTransfersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCarTransfersRequestValidationError) ErrorName() string {
+	return "ListCarTransfersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCarTransfersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCarTransfersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCarTransfersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCarTransfersRequestValidationError{}
+
+// Validate checks the field values on ListCarTransfersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCarTransfersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCarTransfersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCarTransfersResponseMultiError, or nil if none found.
+func (m *ListCarTransfersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCarTransfersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCarTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCarTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCarTransfersResponseValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCarTransfersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCarTransfersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCarTransfersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCarTransfersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCarTransfersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCarTransfersResponseMultiError) AllErrors() []error { return m }
+
+// ListCarTransfersResponseValidationError is the validation error returned by
+// ListCarTransfersResponse.Validate if the designated constraints aren't met.
+type ListCarTransfersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCarTransfersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCarTransfersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCarTransfersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCarTransfersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCarTransfersResponseValidationError) ErrorName() string {
+	return "ListCarTransfersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCarTransfersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCarTransfersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCarTransfersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCarTransfersResponseValidationError{}
+
+// Validate checks the field values on CreateOrganizationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrganizationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrganizationRequestMultiError, or nil if none found.
+func (m *CreateOrganizationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrganizationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateOrganizationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrganizationRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateOrganizationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrganizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrganizationRequestValidationError is the validation error returned by
+// CreateOrganizationRequest.Validate if the designated constraints aren't met.
+type CreateOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrganizationRequestValidationError) ErrorName() string {
+	return "CreateOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrganizationRequestValidationError{}
+
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrganizationValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrganizationValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrganizationValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return OrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMultiError) AllErrors() []error { return m }
+
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrganizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationValidationError{}
+
+// Validate checks the field values on OrganizationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationMemberMultiError, or nil if none found.
+func (m *OrganizationMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return OrganizationMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMemberMultiError is an error wrapping multiple validation errors
+// returned by OrganizationMember.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationMemberMultiError) AllErrors() []error { return m }
+
+// OrganizationMemberValidationError is the validation error returned by
+// OrganizationMember.Validate if the designated constraints aren't met.
+type OrganizationMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationMemberValidationError) ErrorName() string {
+	return "OrganizationMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationMemberValidationError{}
+
+// Validate checks the field values on OrganizationId with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrganizationId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationId with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationIdMultiError,
+// or nil if none found.
+func (m *OrganizationId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return OrganizationIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationIdMultiError is an error wrapping multiple validation errors
+// returned by OrganizationId.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationIdMultiError) AllErrors() []error { return m }
+
+// OrganizationIdValidationError is the validation error returned by
+// OrganizationId.Validate if the designated constraints aren't met.
+type OrganizationIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationIdValidationError) ErrorName() string { return "OrganizationIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrganizationIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationIdValidationError{}
+
+// Validate checks the field values on ListOrganizationsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateCommentRequest) Validate() error {
+func (m *ListOrganizationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrganizationsResponseMultiError, or nil if none found.
+func (m *ListOrganizationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrganizations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrganizationsResponseValidationError{
+						field:  fmt.Sprintf("Organizations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrganizationsResponseValidationError{
+						field:  fmt.Sprintf("Organizations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrganizationsResponseValidationError{
+					field:  fmt.Sprintf("Organizations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOrganizationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListOrganizationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListOrganizationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrganizationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrganizationsResponseMultiError) AllErrors() []error { return m }
+
+// ListOrganizationsResponseValidationError is the validation error returned by
+// ListOrganizationsResponse.Validate if the designated constraints aren't met.
+type ListOrganizationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrganizationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrganizationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrganizationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrganizationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrganizationsResponseValidationError) ErrorName() string {
+	return "ListOrganizationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrganizationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrganizationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrganizationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrganizationsResponseValidationError{}
+
+// Validate checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddOrganizationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrganizationMemberRequestMultiError, or nil if none found.
+func (m *AddOrganizationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrganizationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return AddOrganizationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by AddOrganizationMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrganizationMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddOrganizationMemberRequestValidationError is the validation error returned
+// by AddOrganizationMemberRequest.Validate if the designated constraints
+// aren't met.
+type AddOrganizationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrganizationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrganizationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrganizationMemberRequestValidationError) ErrorName() string {
+	return "AddOrganizationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddOrganizationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrganizationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrganizationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrganizationMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveOrganizationMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveOrganizationMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveOrganizationMemberRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveOrganizationMemberRequestMultiError, or nil if none found.
+func (m *RemoveOrganizationMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveOrganizationMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return RemoveOrganizationMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveOrganizationMemberRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveOrganizationMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveOrganizationMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveOrganizationMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveOrganizationMemberRequestValidationError is the validation error
+// returned by RemoveOrganizationMemberRequest.Validate if the designated
+// constraints aren't met.
+type RemoveOrganizationMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveOrganizationMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveOrganizationMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveOrganizationMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveOrganizationMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveOrganizationMemberRequestValidationError) ErrorName() string {
+	return "RemoveOrganizationMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveOrganizationMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveOrganizationMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveOrganizationMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveOrganizationMemberRequestValidationError{}
+
+// Validate checks the field values on AssignCarToOrganizationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignCarToOrganizationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateCommentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateCommentRequestMultiError, or nil if none found.
-func (m *UpdateCommentRequest) ValidateAll() error {
+// ValidateAll checks the field values on AssignCarToOrganizationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AssignCarToOrganizationRequestMultiError, or nil if none found.
+func (m *AssignCarToOrganizationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateCommentRequest) validate(all bool) error {
+func (m *AssignCarToOrganizationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for CarId
 
-	// no validation rules for Content
+	// no validation rules for OrganizationId
 
 	if len(errors) > 0 {
-		return UpdateCommentRequestMultiError(errors)
+		return AssignCarToOrganizationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateCommentRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateCommentRequest.ValidateAll() if the designated
+// AssignCarToOrganizationRequestMultiError is an error wrapping multiple
+// validation errors returned by AssignCarToOrganizationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AssignCarToOrganizationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignCarToOrganizationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignCarToOrganizationRequestMultiError) AllErrors() []error { return m }
+
+// AssignCarToOrganizationRequestValidationError is the validation error
+// returned by AssignCarToOrganizationRequest.Validate if the designated
 // constraints aren't met.
-type UpdateCommentRequestMultiError []error
+type AssignCarToOrganizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignCarToOrganizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignCarToOrganizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignCarToOrganizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignCarToOrganizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignCarToOrganizationRequestValidationError) ErrorName() string {
+	return "AssignCarToOrganizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignCarToOrganizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignCarToOrganizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignCarToOrganizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignCarToOrganizationRequestValidationError{}
+
+// Validate checks the field values on ListOrganizationMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrganizationMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrganizationMessagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListOrganizationMessagesRequestMultiError, or nil if none found.
+func (m *ListOrganizationMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrganizationMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListOrganizationMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrganizationMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListOrganizationMessagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListOrganizationMessagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateCommentRequestMultiError) Error() string {
+func (m ListOrganizationMessagesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5104,11 +12771,12 @@ func (m UpdateCommentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateCommentRequestMultiError) AllErrors() []error { return m }
+func (m ListOrganizationMessagesRequestMultiError) AllErrors() []error { return m }
 
-// UpdateCommentRequestValidationError is the validation error returned by
-// UpdateCommentRequest.Validate if the designated constraints aren't met.
-type UpdateCommentRequestValidationError struct {
+// ListOrganizationMessagesRequestValidationError is the validation error
+// returned by ListOrganizationMessagesRequest.Validate if the designated
+// constraints aren't met.
+type ListOrganizationMessagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5116,24 +12784,24 @@ type UpdateCommentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateCommentRequestValidationError) Field() string { return e.field }
+func (e ListOrganizationMessagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateCommentRequestValidationError) Reason() string { return e.reason }
+func (e ListOrganizationMessagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateCommentRequestValidationError) Cause() error { return e.cause }
+func (e ListOrganizationMessagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateCommentRequestValidationError) Key() bool { return e.key }
+func (e ListOrganizationMessagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateCommentRequestValidationError) ErrorName() string {
-	return "UpdateCommentRequestValidationError"
+func (e ListOrganizationMessagesRequestValidationError) ErrorName() string {
+	return "ListOrganizationMessagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateCommentRequestValidationError) Error() string {
+func (e ListOrganizationMessagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5145,14 +12813,14 @@ func (e UpdateCommentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateCommentRequest.%s: %s%s",
+		"invalid %sListOrganizationMessagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateCommentRequestValidationError{}
+var _ error = ListOrganizationMessagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5160,45 +12828,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateCommentRequestValidationError{}
+} = ListOrganizationMessagesRequestValidationError{}
 
-// Validate checks the field values on CommentId with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CommentId) Validate() error {
+// Validate checks the field values on OrganizationMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationMessagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentId with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommentIdMultiError, or nil
-// if none found.
-func (m *CommentId) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationMessagesResponseMultiError, or nil if none found.
+func (m *OrganizationMessagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentId) validate(all bool) error {
+func (m *OrganizationMessagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrganizationMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrganizationMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrganizationMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CommentIdMultiError(errors)
+		return OrganizationMessagesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentIdMultiError is an error wrapping multiple validation errors returned
-// by CommentId.ValidateAll() if the designated constraints aren't met.
-type CommentIdMultiError []error
+// OrganizationMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by OrganizationMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type OrganizationMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentIdMultiError) Error() string {
+func (m OrganizationMessagesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5207,11 +12908,12 @@ func (m CommentIdMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentIdMultiError) AllErrors() []error { return m }
+func (m OrganizationMessagesResponseMultiError) AllErrors() []error { return m }
 
-// CommentIdValidationError is the validation error returned by
-// CommentId.Validate if the designated constraints aren't met.
-type CommentIdValidationError struct {
+// OrganizationMessagesResponseValidationError is the validation error returned
+// by OrganizationMessagesResponse.Validate if the designated constraints
+// aren't met.
+type OrganizationMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5219,22 +12921,24 @@ type CommentIdValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentIdValidationError) Field() string { return e.field }
+func (e OrganizationMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentIdValidationError) Reason() string { return e.reason }
+func (e OrganizationMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentIdValidationError) Cause() error { return e.cause }
+func (e OrganizationMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentIdValidationError) Key() bool { return e.key }
+func (e OrganizationMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentIdValidationError) ErrorName() string { return "CommentIdValidationError" }
+func (e OrganizationMessagesResponseValidationError) ErrorName() string {
+	return "OrganizationMessagesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommentIdValidationError) Error() string {
+func (e OrganizationMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5246,14 +12950,14 @@ func (e CommentIdValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentId.%s: %s%s",
+		"invalid %sOrganizationMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentIdValidationError{}
+var _ error = OrganizationMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5261,45 +12965,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentIdValidationError{}
+} = OrganizationMessagesResponseValidationError{}
 
-// Validate checks the field values on BoolCheck with the rules defined in the
+// Validate checks the field values on Quota with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *BoolCheck) Validate() error {
+func (m *Quota) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BoolCheck with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BoolCheckMultiError, or nil
-// if none found.
-func (m *BoolCheck) ValidateAll() error {
+// ValidateAll checks the field values on Quota with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QuotaMultiError, or nil if none found.
+func (m *Quota) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BoolCheck) validate(all bool) error {
+func (m *Quota) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Result
+	// no validation rules for Plan
+
+	// no validation rules for PlanName
+
+	// no validation rules for MaxActiveListings
+
+	// no validation rules for ActiveListings
+
+	// no validation rules for MaxImagesPerCar
 
 	if len(errors) > 0 {
-		return BoolCheckMultiError(errors)
+		return QuotaMultiError(errors)
 	}
 
 	return nil
 }
 
-// BoolCheckMultiError is an error wrapping multiple validation errors returned
-// by BoolCheck.ValidateAll() if the designated constraints aren't met.
-type BoolCheckMultiError []error
+// QuotaMultiError is an error wrapping multiple validation errors returned by
+// Quota.ValidateAll() if the designated constraints aren't met.
+type QuotaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BoolCheckMultiError) Error() string {
+func (m QuotaMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5308,11 +13019,11 @@ func (m BoolCheckMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BoolCheckMultiError) AllErrors() []error { return m }
+func (m QuotaMultiError) AllErrors() []error { return m }
 
-// BoolCheckValidationError is the validation error returned by
-// BoolCheck.Validate if the designated constraints aren't met.
-type BoolCheckValidationError struct {
+// QuotaValidationError is the validation error returned by Quota.Validate if
+// the designated constraints aren't met.
+type QuotaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5320,22 +13031,22 @@ type BoolCheckValidationError struct {
 }
 
 // Field function returns field value.
-func (e BoolCheckValidationError) Field() string { return e.field }
+func (e QuotaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BoolCheckValidationError) Reason() string { return e.reason }
+func (e QuotaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BoolCheckValidationError) Cause() error { return e.cause }
+func (e QuotaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BoolCheckValidationError) Key() bool { return e.key }
+func (e QuotaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BoolCheckValidationError) ErrorName() string { return "BoolCheckValidationError" }
+func (e QuotaValidationError) ErrorName() string { return "QuotaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e BoolCheckValidationError) Error() string {
+func (e QuotaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5347,14 +13058,14 @@ func (e BoolCheckValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBoolCheck.%s: %s%s",
+		"invalid %sQuota.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BoolCheckValidationError{}
+var _ error = QuotaValidationError{}
 
 var _ interface {
 	Field() string
@@ -5362,24 +13073,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BoolCheckValidationError{}
+} = QuotaValidationError{}
 
-// Validate checks the field values on BoolCheckComment with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *BoolCheckComment) Validate() error {
+// Validate checks the field values on SetUserPlanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserPlanRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BoolCheckComment with the rules
+// ValidateAll checks the field values on SetUserPlanRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// BoolCheckCommentMultiError, or nil if none found.
-func (m *BoolCheckComment) ValidateAll() error {
+// SetUserPlanRequestMultiError, or nil if none found.
+func (m *SetUserPlanRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BoolCheckComment) validate(all bool) error {
+func (m *SetUserPlanRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5388,22 +13099,24 @@ func (m *BoolCheckComment) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	// no validation rules for CommentId
+	// no validation rules for Plan
+
+	// no validation rules for ExpiresAt
 
 	if len(errors) > 0 {
-		return BoolCheckCommentMultiError(errors)
+		return SetUserPlanRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BoolCheckCommentMultiError is an error wrapping multiple validation errors
-// returned by BoolCheckComment.ValidateAll() if the designated constraints
+// SetUserPlanRequestMultiError is an error wrapping multiple validation errors
+// returned by SetUserPlanRequest.ValidateAll() if the designated constraints
 // aren't met.
-type BoolCheckCommentMultiError []error
+type SetUserPlanRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BoolCheckCommentMultiError) Error() string {
+func (m SetUserPlanRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5412,11 +13125,11 @@ func (m BoolCheckCommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BoolCheckCommentMultiError) AllErrors() []error { return m }
+func (m SetUserPlanRequestMultiError) AllErrors() []error { return m }
 
-// BoolCheckCommentValidationError is the validation error returned by
-// BoolCheckComment.Validate if the designated constraints aren't met.
-type BoolCheckCommentValidationError struct {
+// SetUserPlanRequestValidationError is the validation error returned by
+// SetUserPlanRequest.Validate if the designated constraints aren't met.
+type SetUserPlanRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5424,22 +13137,24 @@ type BoolCheckCommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e BoolCheckCommentValidationError) Field() string { return e.field }
+func (e SetUserPlanRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BoolCheckCommentValidationError) Reason() string { return e.reason }
+func (e SetUserPlanRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BoolCheckCommentValidationError) Cause() error { return e.cause }
+func (e SetUserPlanRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BoolCheckCommentValidationError) Key() bool { return e.key }
+func (e SetUserPlanRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BoolCheckCommentValidationError) ErrorName() string { return "BoolCheckCommentValidationError" }
+func (e SetUserPlanRequestValidationError) ErrorName() string {
+	return "SetUserPlanRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e BoolCheckCommentValidationError) Error() string {
+func (e SetUserPlanRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5451,14 +13166,14 @@ func (e BoolCheckCommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBoolCheckComment.%s: %s%s",
+		"invalid %sSetUserPlanRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BoolCheckCommentValidationError{}
+var _ error = SetUserPlanRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5466,4 +13181,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BoolCheckCommentValidationError{}
+} = SetUserPlanRequestValidationError{}
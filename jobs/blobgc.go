@@ -0,0 +1,246 @@
+package jobs
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"wegugin/storage/blob"
+	"wegugin/storage/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// gcMetrics - /v1/metrics orqali ko'rinadigan tozalash ko'rsatkichlari
+var gcMetrics = expvar.NewMap("blob_gc")
+
+// gcSampleSize - dry-run hisobotida ko'rsatiladigan kalitlar soni
+const gcSampleSize = 20
+
+// carsPrefix - yuklangan rasmlar va ochiq media kalitlari: cars/{car_id}/...
+const carsPrefix = "cars/"
+
+// carPrefixes - mashinalar bo'yicha guruhlanadigan kalitlar (yopiq hujjatlar ham images yozuvlarida)
+var carPrefixes = []string{carsPrefix, blob.PrivateCarsRoot}
+
+// BlobGC - saqlash qatlamidagi kalitlarni images yozuvlari bilan solishtiradi va hech bir
+// yozuv (o'chirilganlari ham) ishlatmaydigan fayllarni grace muddatidan keyin o'chiradi
+type BlobGC struct {
+	store    postgres.Store
+	blobs    blob.BlobStore
+	logger   *slog.Logger
+	grace    time.Duration
+	interval time.Duration
+	dryRun   bool
+}
+
+// GCSummary - bitta tozalash natijasi
+type GCSummary struct {
+	Scanned      int      // ko'rib chiqilgan kalitlar
+	Orphans      int      // hech bir yozuvga tegishli bo'lmagan, grace dan eski fayllar
+	OrphanBytes  int64    // ular egallagan joy
+	Deleted      int      // haqiqatda o'chirilganlari (dry-run da 0)
+	DeletedBytes int64    // bo'shatilgan joy
+	MissingFiles int      // fayli topilmagan faol images yozuvlari (o'chirilmaydi, faqat hisobot)
+	Errors       int      // o'chirib bo'lmagan fayllar
+	Sample       []string // hisobot uchun orphan kalitlardan namuna
+}
+
+func NewBlobGC(store postgres.Store, blobs blob.BlobStore, logger *slog.Logger, grace, interval time.Duration, dryRun bool) *BlobGC {
+	if interval <= 0 {
+		interval = 6 * time.Hour
+	}
+	return &BlobGC{
+		store:    store,
+		blobs:    blobs,
+		logger:   logger,
+		grace:    grace,
+		interval: interval,
+		dryRun:   dryRun,
+	}
+}
+
+// Run - ctx bekor qilinmaguncha har interval da Collect ni chaqiradi
+func (g *BlobGC) Run(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := g.Collect(ctx); err != nil {
+			gcMetrics.Add("failed_runs", 1)
+			g.logger.Error("failed to collect orphan blobs", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect - bitta to'liq solishtirish. Kalitlar tartiblangan holda keladi, shuning uchun
+// bitta mashinaning fayllari ketma-ket bo'ladi va har bir mashina uchun bitta so'rov yuboriladi
+func (g *BlobGC) Collect(ctx context.Context) (GCSummary, error) {
+	var sum GCSummary
+	cutoff := time.Now().Add(-g.grace)
+
+	// 1. Yuklangan fayllar: mashina bo'yicha guruhlab yozuvlar bilan solishtirish
+	for _, prefix := range carPrefixes {
+		if err := g.collectPrefix(ctx, prefix, cutoff, &sum); err != nil {
+			return sum, err
+		}
+	}
+
+	// 2. Tasdiqlanmagan to'g'ridan-to'g'ri yuklashlar: grace dan eskilari endi tasdiqlanmaydi
+	err := g.blobs.List(ctx, blob.IncomingRoot, func(obj blob.Object) error {
+		sum.Scanned++
+		if obj.ModTime.Before(cutoff) {
+			g.remove(ctx, obj, &sum)
+		}
+		return nil
+	})
+	if err != nil {
+		return sum, err
+	}
+
+	g.report(sum)
+	return sum, nil
+}
+
+// collectPrefix - prefix/{car_id}/... kalitlarini mashina bo'yicha guruhlab solishtiradi
+func (g *BlobGC) collectPrefix(ctx context.Context, prefix string, cutoff time.Time, sum *GCSummary) error {
+	var carID string
+	var group []blob.Object
+	err := g.blobs.List(ctx, prefix, func(obj blob.Object) error {
+		sum.Scanned++
+		id, ok := carIDFromKey(strings.TrimPrefix(obj.Key, prefix))
+		if !ok {
+			return nil // noma'lum tuzilishdagi kalitlarga tegilmaydi
+		}
+		if id != carID && len(group) > 0 {
+			if err := g.collectCar(ctx, prefix, carID, group, cutoff, sum); err != nil {
+				return err
+			}
+			group = group[:0]
+		}
+		carID = id
+		group = append(group, obj)
+		return nil
+	})
+	if err == nil && len(group) > 0 {
+		err = g.collectCar(ctx, prefix, carID, group, cutoff, sum)
+	}
+	return err
+}
+
+// collectCar - bitta mashinaning prefix ostidagi fayllarini uning barcha images yozuvlari bilan solishtirish
+func (g *BlobGC) collectCar(ctx context.Context, prefix, carID string, objs []blob.Object, cutoff time.Time, sum *GCSummary) error {
+	carUUID, _ := uuid.Parse(carID) // carIDFromKey tekshirgan
+	rows, err := g.store.ListCarImageFiles(ctx, pgtype.UUID{Bytes: carUUID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("list images of car %s: %w", carID, err)
+	}
+
+	// Trashdagi yozuvlarning fayllari ham saqlanadi, aks holda tiklangan rasm bo'sh qoladi
+	referenced := make(map[string]bool, len(rows)*(len(blob.Variants)+1))
+	for _, row := range rows {
+		referenced[row.Filename] = true
+		for _, v := range row.Variants {
+			referenced[blob.VariantKey(row.Filename, v)] = true
+		}
+	}
+	existing := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		existing[obj.Key] = true
+		if !referenced[obj.Key] && obj.ModTime.Before(cutoff) {
+			g.remove(ctx, obj, sum)
+		}
+	}
+
+	for _, row := range rows {
+		if row.DeletedAt == 0 && strings.HasPrefix(row.Filename, prefix) && !existing[row.Filename] &&
+			row.UploadedAt.Time.Before(cutoff) {
+			sum.MissingFiles++
+			g.logger.Warn("image file is missing from blob store", "car_id", carID, "key", row.Filename)
+		}
+	}
+	return nil
+}
+
+// remove - orphan faylni hisobga oladi va dry-run bo'lmasa o'chiradi
+func (g *BlobGC) remove(ctx context.Context, obj blob.Object, sum *GCSummary) {
+	sum.Orphans++
+	sum.OrphanBytes += obj.Size
+	if len(sum.Sample) < gcSampleSize {
+		sum.Sample = append(sum.Sample, obj.Key)
+	}
+	if g.dryRun {
+		return
+	}
+	if err := g.blobs.Delete(ctx, obj.Key); err != nil {
+		sum.Errors++
+		g.logger.Warn("failed to delete orphan blob", "key", obj.Key, "error", err)
+		return
+	}
+	sum.Deleted++
+	sum.DeletedBytes += obj.Size
+}
+
+// report - ko'rsatkichlarni yangilaydi va natijani log qiladi
+func (g *BlobGC) report(sum GCSummary) {
+	gcMetrics.Add("runs", 1)
+	gcMetrics.Add("deleted_total", int64(sum.Deleted))
+	gcMetrics.Add("reclaimed_bytes_total", sum.DeletedBytes)
+	gcMetrics.Add("delete_errors_total", int64(sum.Errors))
+	setInt("last_run_unix", time.Now().Unix())
+	setInt("last_scanned", int64(sum.Scanned))
+	setInt("last_orphans", int64(sum.Orphans))
+	setInt("last_orphan_bytes", sum.OrphanBytes)
+	setInt("last_missing_files", int64(sum.MissingFiles))
+	if g.dryRun {
+		setInt("dry_run", 1)
+	} else {
+		setInt("dry_run", 0)
+	}
+
+	if g.dryRun {
+		g.logger.Info("blob gc dry run: nothing deleted",
+			"scanned", sum.Scanned,
+			"would_delete", sum.Orphans,
+			"would_reclaim_bytes", sum.OrphanBytes,
+			"missing_files", sum.MissingFiles,
+			"sample", sum.Sample,
+		)
+		return
+	}
+	g.logger.Info("blob gc finished",
+		"scanned", sum.Scanned,
+		"orphans", sum.Orphans,
+		"deleted", sum.Deleted,
+		"reclaimed_bytes", sum.DeletedBytes,
+		"errors", sum.Errors,
+		"missing_files", sum.MissingFiles,
+	)
+}
+
+func setInt(name string, value int64) {
+	v := new(expvar.Int)
+	v.Set(value)
+	gcMetrics.Set(name, v)
+}
+
+// carIDFromKey - "{car_id}/..." dan car_id ni ajratadi
+func carIDFromKey(rest string) (string, bool) {
+	id, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return "", false
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+	return id, true
+}
@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+	"wegugin/storage/postgres"
+	"wegugin/storage/postgres/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TrashPurger - saqlash muddati o'tgan soft-delete yozuvlarini butunlay o'chiradi
+type TrashPurger struct {
+	store     postgres.Store
+	logger    *slog.Logger
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashPurger(store postgres.Store, logger *slog.Logger, retention, interval time.Duration) *TrashPurger {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &TrashPurger{
+		store:     store,
+		logger:    logger,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run - ctx bekor qilinmaguncha har interval da Purge ni chaqiradi
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Purge(ctx); err != nil {
+			p.logger.Error("failed to purge trash", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge - retention muddatidan oldin o'chirilgan yozuvlarni bitta tranzaksiyada tozalaydi
+func (p *TrashPurger) Purge(ctx context.Context) error {
+	cutoff := pgtype.Int8{Int64: time.Now().Add(-p.retention).Unix(), Valid: true}
+
+	var saved, comments, images, cars, notifications int64
+	err := p.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		// Bog'liq yozuvlar mashinadan oldin o'chiriladi (foreign key)
+		if saved, err = q.PurgeSavedCarsOfDeletedCars(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge saved cars: %w", err)
+		}
+		if comments, err = q.PurgeDeletedComments(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge comments: %w", err)
+		}
+		if images, err = q.PurgeDeletedImages(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge images: %w", err)
+		}
+		if cars, err = q.PurgeDeletedCars(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge cars: %w", err)
+		}
+		if notifications, err = q.PurgeDeletedNotifications(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge notifications: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("trash purged",
+		"cars", cars,
+		"images", images,
+		"comments", comments,
+		"saved_cars", saved,
+		"notifications", notifications,
+	)
+	return nil
+}
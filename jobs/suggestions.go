@@ -0,0 +1,86 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+	"wegugin/storage/postgres"
+	"wegugin/storage/postgres/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Takliflar indeksiga kirishi uchun so'rovni kamida shuncha turli qidiruvchi izlagan bo'lishi kerak
+const minSuggestedQueryCount = 3
+
+// SuggestionIndexer - qidiruv takliflari indeksini qayta quradi va eski so'rovlar jurnalini tozalaydi
+type SuggestionIndexer struct {
+	store     postgres.Store
+	logger    *slog.Logger
+	window    time.Duration
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewSuggestionIndexer(store postgres.Store, logger *slog.Logger, window, retention, interval time.Duration) *SuggestionIndexer {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	return &SuggestionIndexer{
+		store:     store,
+		logger:    logger,
+		window:    window,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run - ctx bekor qilinmaguncha har interval da Refresh ni chaqiradi
+func (ix *SuggestionIndexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(ix.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := ix.Refresh(ctx); err != nil {
+			ix.logger.Error("failed to refresh search suggestions", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh - indeksni bitta tranzaksiyada qayta quradi, o'qiyotganlar eski yoki yangi holatni to'liq ko'radi
+func (ix *SuggestionIndexer) Refresh(ctx context.Context) error {
+	now := time.Now()
+	since := pgtype.Timestamptz{Time: now.Add(-ix.window), Valid: true}
+	cutoff := pgtype.Timestamptz{Time: now.Add(-ix.retention), Valid: true}
+
+	var terms, purged int64
+	err := ix.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		if purged, err = q.PurgeSearchQueries(ctx, cutoff); err != nil {
+			return fmt.Errorf("purge search queries: %w", err)
+		}
+		if err := q.ClearSearchSuggestions(ctx); err != nil {
+			return fmt.Errorf("clear suggestions: %w", err)
+		}
+		if terms, err = q.RebuildSearchSuggestions(ctx, sqlc.RebuildSearchSuggestionsParams{
+			Since:    since,
+			MinCount: pgtype.Int4{Int32: minSuggestedQueryCount, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("rebuild suggestions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ix.logger.Info("search suggestions refreshed", "terms", terms, "purged_queries", purged)
+	return nil
+}
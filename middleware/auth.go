@@ -51,6 +51,25 @@ var (
 		"/v1/comments/car/{car_id}": {
 			"DELETE": true,
 		},
+		"/v1/catalog/autocomplete": {
+			"GET": true, // AutocompleteMakeModel
+		},
+		"/v1/search/suggest": {
+			"GET": true, // SuggestSearch
+		},
+		"/v1/search/trending": {
+			"GET": true, // GetTrendingSearches
+		},
+		"/v1/reviews": {
+			"GET": true, // ListReviews
+		},
+		"/v1/sellers/{seller_id}/summary": {
+			"GET": true, // GetSellerSummary
+		},
+		"/v1/files/": {
+			"GET": true, // Yuklangan rasmlar va ularning o'lchamlari (private/ kalitlar imzo bilan)
+			"PUT": true, // Imzolangan URL orqali to'g'ridan-to'g'ri yuklash
+		},
 	}
 )
 
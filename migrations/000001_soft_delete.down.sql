@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_notifications_deleted_at;
+DROP INDEX IF EXISTS idx_images_deleted_at;
+DROP INDEX IF EXISTS idx_comments_deleted_at;
+DROP INDEX IF EXISTS idx_cars_deleted_at;
+
+-- comments, images va notifications jadvallaridagi deleted_at ustuni
+-- ushbu migratsiyadan oldin ham mavjud bo'lgan, shuning uchun faqat cars dan olib tashlanadi
+ALTER TABLE cars DROP COLUMN IF EXISTS deleted_at;
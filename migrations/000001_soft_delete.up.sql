@@ -0,0 +1,11 @@
+-- deleted_at: 0 = faol yozuv, aks holda o'chirilgan vaqt (unix epoch, soniya)
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS deleted_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS deleted_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS deleted_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS deleted_at BIGINT NOT NULL DEFAULT 0;
+
+-- Purge job uchun: faqat o'chirilgan yozuvlar indekslanadi
+CREATE INDEX IF NOT EXISTS idx_cars_deleted_at ON cars (deleted_at) WHERE deleted_at <> 0;
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at) WHERE deleted_at <> 0;
+CREATE INDEX IF NOT EXISTS idx_images_deleted_at ON images (deleted_at) WHERE deleted_at <> 0;
+CREATE INDEX IF NOT EXISTS idx_notifications_deleted_at ON notifications (deleted_at) WHERE deleted_at <> 0;
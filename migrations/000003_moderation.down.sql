@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS moderation_queue;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS moderation_status;
+ALTER TABLE cars DROP COLUMN IF EXISTS moderation_status;
@@ -0,0 +1,19 @@
+-- moderation_status: pending | approved | rejected | changes_requested
+-- Mavjud yozuvlar tasdiqlangan hisoblanadi
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(20) NOT NULL DEFAULT 'approved';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(20) NOT NULL DEFAULT 'approved';
+
+CREATE TABLE IF NOT EXISTS moderation_queue (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    owner_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reason TEXT,
+    reviewed_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_moderation_queue_pending ON moderation_queue (created_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_moderation_queue_entity ON moderation_queue (entity_type, entity_id);
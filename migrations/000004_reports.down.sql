@@ -0,0 +1,3 @@
+ALTER TABLE messages DROP COLUMN IF EXISTS hidden;
+
+DROP TABLE IF EXISTS reports;
@@ -0,0 +1,24 @@
+-- Shikoyatlar soni chegaraga yetganda mashina va kommentariyalar moderation_status = 'hidden' bo'ladi,
+-- content_removed qarori bilan esa 'removed'
+
+-- Shikoyatlar: bir foydalanuvchi bitta obyekt haqida faqat bir marta shikoyat qiladi
+CREATE TABLE IF NOT EXISTS reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    reporter_id UUID NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    details TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    outcome VARCHAR(30),
+    note TEXT,
+    resolved_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (entity_type, entity_id, reporter_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports (status, created_at);
+
+-- Xabarlarda moderation_status yo'q, yashirish uchun alohida bayroq
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS car_model_aliases;
+DROP TABLE IF EXISTS car_make_aliases;
+DROP TABLE IF EXISTS car_generations;
+DROP TABLE IF EXISTS car_models;
+DROP TABLE IF EXISTS car_makes;
@@ -0,0 +1,38 @@
+-- Marka/model katalogi. name_key - kichik harf, faqat harf va raqamlar ("B.M.W." -> "bmw")
+CREATE TABLE IF NOT EXISTS car_makes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    name_key VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS car_models (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    make_id UUID NOT NULL REFERENCES car_makes(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    name_key VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (make_id, name_key)
+);
+
+CREATE TABLE IF NOT EXISTS car_generations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    model_id UUID NOT NULL REFERENCES car_models(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    year_from INTEGER NOT NULL,
+    year_to INTEGER,
+    UNIQUE (model_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS car_make_aliases (
+    alias_key VARCHAR(100) PRIMARY KEY,
+    make_id UUID NOT NULL REFERENCES car_makes(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS car_model_aliases (
+    model_id UUID NOT NULL REFERENCES car_models(id) ON DELETE CASCADE,
+    alias_key VARCHAR(100) NOT NULL,
+    PRIMARY KEY (model_id, alias_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_car_model_aliases_key ON car_model_aliases (alias_key);
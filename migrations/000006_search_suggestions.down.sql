@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS search_suggestions;
+DROP TABLE IF EXISTS search_queries;
@@ -0,0 +1,19 @@
+-- Qidiruv so'rovlari jurnali: foydalanuvchi ID si va IP saqlanmaydi, faqat normallashtirilgan matn
+CREATE TABLE IF NOT EXISTS search_queries (
+    id BIGSERIAL PRIMARY KEY,
+    query VARCHAR(100) NOT NULL,
+    results INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_queries_created_at ON search_queries (created_at);
+
+-- Fon jobi tomonidan qayta quriladigan takliflar indeksi
+CREATE TABLE IF NOT EXISTS search_suggestions (
+    term_key VARCHAR(200) PRIMARY KEY,
+    term VARCHAR(200) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    weight BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_suggestions_prefix ON search_suggestions (term_key text_pattern_ops);
@@ -0,0 +1,8 @@
+-- Tavsif tarjimalari (uz, ru, en). cars.description asl tavsif bo'lib qoladi
+CREATE TABLE IF NOT EXISTS car_descriptions (
+    car_id UUID NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    description TEXT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (car_id, locale)
+);
@@ -0,0 +1,14 @@
+-- E'lonni boshqa foydalanuvchiga o'tkazish so'rovlari: pending | accepted | declined | cancelled
+CREATE TABLE IF NOT EXISTS car_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    car_id UUID NOT NULL REFERENCES cars(id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL,
+    to_user_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Bitta mashina uchun bir vaqtda faqat bitta kutilayotgan so'rov
+CREATE UNIQUE INDEX IF NOT EXISTS idx_car_transfers_pending ON car_transfers (car_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_car_transfers_users ON car_transfers (from_user_id, to_user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE messages DROP COLUMN IF EXISTS car_id;
+ALTER TABLE cars DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_cars_owner_active;
+
+DROP TABLE IF EXISTS user_plans;
+DROP TABLE IF EXISTS plans;
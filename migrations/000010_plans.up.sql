@@ -0,0 +1,23 @@
+-- Tarif rejalari: faol e'lonlar va bitta e'londagi rasmlar soni bo'yicha cheklovlar
+CREATE TABLE IF NOT EXISTS plans (
+    code VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    max_active_listings INTEGER NOT NULL,
+    max_images_per_car INTEGER NOT NULL
+);
+
+INSERT INTO plans (code, name, max_active_listings, max_images_per_car) VALUES
+    ('free', 'Private seller', 3, 10),
+    ('dealer_basic', 'Dealer Basic', 50, 20),
+    ('dealer_pro', 'Dealer Pro', 500, 40)
+ON CONFLICT (code) DO NOTHING;
+
+-- Foydalanuvchiga biriktirilgan reja. Yozuv bo'lmasa yoki muddati o'tgan bo'lsa 'free' amal qiladi
+CREATE TABLE IF NOT EXISTS user_plans (
+    user_id UUID PRIMARY KEY,
+    plan_code VARCHAR(50) NOT NULL REFERENCES plans(code),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cars_owner_active ON cars (owner_id) WHERE deleted_at = 0;
@@ -0,0 +1,2 @@
+-- Yuklashda yaratilgan o'lchamlar (thumb, medium, large). Kalitlari asl fayl kalitidan hosil qilinadi
+ALTER TABLE images ADD COLUMN IF NOT EXISTS variants TEXT[] NOT NULL DEFAULT '{}';
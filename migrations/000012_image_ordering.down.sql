@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_images_car_position;
+DROP INDEX IF EXISTS idx_images_cover;
+
+ALTER TABLE images DROP COLUMN IF EXISTS is_cover;
+ALTER TABLE images DROP COLUMN IF EXISTS position;
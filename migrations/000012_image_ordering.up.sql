@@ -0,0 +1,18 @@
+-- Rasmlar tartibi va muqova rasmi. Har bir mashinada bittadan ortiq muqova bo'lmaydi
+ALTER TABLE images ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS is_cover BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Mavjud rasmlar yuklangan vaqti bo'yicha tartiblanadi, birinchisi muqova bo'ladi
+UPDATE images i
+SET position = o.rn - 1
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY car_id ORDER BY uploaded_at, id) AS rn
+    FROM images
+    WHERE deleted_at = 0
+) o
+WHERE i.id = o.id;
+
+UPDATE images SET is_cover = TRUE WHERE position = 0 AND deleted_at = 0;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_images_cover ON images (car_id) WHERE is_cover;
+CREATE INDEX IF NOT EXISTS idx_images_car_position ON images (car_id, position) WHERE deleted_at = 0;
@@ -0,0 +1,4 @@
+ALTER TABLE images DROP COLUMN IF EXISTS checksum;
+ALTER TABLE images DROP COLUMN IF EXISTS size_bytes;
+ALTER TABLE images DROP COLUMN IF EXISTS height;
+ALTER TABLE images DROP COLUMN IF EXISTS width;
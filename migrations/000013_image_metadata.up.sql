@@ -0,0 +1,5 @@
+-- Yuklashda tekshirilgan rasm ma'lumotlari. Eski yozuvlarda NULL bo'lib qoladi
+ALTER TABLE images ADD COLUMN IF NOT EXISTS width INTEGER;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS height INTEGER;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS size_bytes BIGINT;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS checksum TEXT; -- qayta kodlangan faylning SHA-256 (hex)
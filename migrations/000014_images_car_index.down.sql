@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_images_car_id;
@@ -0,0 +1,2 @@
+-- Fayl tozalash jobi mashina rasmlarini (o'chirilganlari bilan birga) car_id bo'yicha o'qiydi
+CREATE INDEX IF NOT EXISTS idx_images_car_id ON images (car_id);
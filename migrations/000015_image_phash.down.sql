@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS image_matches;
+
+ALTER TABLE images DROP COLUMN IF EXISTS phash;
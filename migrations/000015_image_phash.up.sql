@@ -0,0 +1,15 @@
+-- Rasmning perseptual xeshi (64 bit DCT pHash). Eski yozuvlarda NULL
+ALTER TABLE images ADD COLUMN IF NOT EXISTS phash BIGINT;
+
+-- Boshqa egalarning e'lonlaridagi deyarli bir xil rasmlar (adminlar ko'rib chiqishi uchun)
+CREATE TABLE IF NOT EXISTS image_matches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    image_id UUID NOT NULL REFERENCES images(id) ON DELETE CASCADE,
+    matched_image_id UUID NOT NULL REFERENCES images(id) ON DELETE CASCADE,
+    distance INTEGER NOT NULL, -- xeshlar orasidagi Hamming masofasi
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (image_id, matched_image_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_image_matches_created_at ON image_matches (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_image_matches_matched ON image_matches (matched_image_id);
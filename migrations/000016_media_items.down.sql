@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_images_car_kind;
+
+-- Rasm bo'lmagan media elementlari eski sxemada ifodalanmaydi
+DELETE FROM images WHERE kind <> 'image';
+
+ALTER TABLE images DROP CONSTRAINT IF EXISTS images_visibility_check;
+ALTER TABLE images DROP CONSTRAINT IF EXISTS images_kind_check;
+
+ALTER TABLE images DROP COLUMN IF EXISTS visibility;
+ALTER TABLE images DROP COLUMN IF EXISTS mime_type;
+ALTER TABLE images DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,22 @@
+-- images jadvali endi umumiy media elementlari: rasm, video va hujjatlar.
+-- Jadval nomi o'zgarmaydi, rasmga oid so'rovlar kind = 'image' bilan cheklanadi
+ALTER TABLE images ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'image';
+ALTER TABLE images ADD COLUMN IF NOT EXISTS mime_type TEXT;
+-- public - hamma ko'radi, private - faqat e'lon egasi (tashkilot muharrirlari) va adminlar
+ALTER TABLE images ADD COLUMN IF NOT EXISTS visibility VARCHAR(20) NOT NULL DEFAULT 'public';
+
+ALTER TABLE images ADD CONSTRAINT images_kind_check CHECK (kind IN ('image', 'video', 'document'));
+ALTER TABLE images ADD CONSTRAINT images_visibility_check CHECK (visibility IN ('public', 'private'));
+
+-- Mavjud rasmlar uchun MIME turi fayl kengaytmasidan
+UPDATE images
+SET mime_type = CASE lower(substring(filename FROM '\.([A-Za-z0-9]+)$'))
+    WHEN 'jpg' THEN 'image/jpeg'
+    WHEN 'jpeg' THEN 'image/jpeg'
+    WHEN 'png' THEN 'image/png'
+    WHEN 'gif' THEN 'image/gif'
+    WHEN 'webp' THEN 'image/webp'
+END
+WHERE mime_type IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_images_car_kind ON images (car_id, kind, position) WHERE deleted_at = 0;
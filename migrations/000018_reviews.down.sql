@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS seller_ratings;
+
+ALTER TABLE cars DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE cars DROP COLUMN IF EXISTS rating_sum;
+
+DROP TABLE IF EXISTS reviews;
@@ -0,0 +1,27 @@
+-- Yulduzli baholar: e'lon (target_type = 'car') yoki sotuvchi (target_type = 'seller') uchun.
+-- Har bir foydalanuvchi bitta obyektga bitta sharh qoldiradi, keyin uni tahrirlashi mumkin
+CREATE TABLE IF NOT EXISTS reviews (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reviewer_id UUID NOT NULL,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('car', 'seller')),
+    target_id UUID NOT NULL, -- cars.id yoki sotuvchining user_id si
+    seller_id UUID NOT NULL, -- sharh yozilgan paytdagi sotuvchi (e'lon uchun - egasi)
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    content TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (reviewer_id, target_type, target_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_target ON reviews (target_type, target_id, created_at DESC);
+
+-- Agregatlar sharhlar o'zgarganda o'sha tranzaksiyada qayta hisoblanadi (o'rtacha = sum / count)
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS rating_sum INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE cars ADD COLUMN IF NOT EXISTS rating_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS seller_ratings (
+    seller_id UUID PRIMARY KEY,
+    rating_sum INTEGER NOT NULL DEFAULT 0,
+    rating_count INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_comments_car_roots_likes;
+DROP INDEX IF EXISTS idx_comments_car_roots_created;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS like_count;
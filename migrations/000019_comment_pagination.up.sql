@@ -0,0 +1,8 @@
+-- like_count - kommentariyaga bosilgan layklar soni ("most_liked" saralash uchun denormalizatsiya)
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS like_count INTEGER NOT NULL DEFAULT 0;
+
+-- Ildiz kommentariyalar sahifalari kursor bo'yicha indeksdan o'qiladi
+CREATE INDEX IF NOT EXISTS idx_comments_car_roots_created ON comments (car_id, created_at, id)
+    WHERE parent_id IS NULL AND deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_comments_car_roots_likes ON comments (car_id, like_count, created_at, id)
+    WHERE parent_id IS NULL AND deleted_at = 0;
@@ -0,0 +1,11 @@
+-- Kommentariyalarga reaksiyalar: har bir foydalanuvchi bitta kommentariyaga bitta reaksiya qo'yadi,
+-- yangisi oldingisini almashtiradi. comments.like_count 'like' reaksiyalaridan qayta hisoblanadi
+CREATE TABLE IF NOT EXISTS comment_reactions (
+    comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    reaction VARCHAR(20) NOT NULL CHECK (reaction IN ('like', 'helpful')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_reactions_user_id ON comment_reactions (user_id);
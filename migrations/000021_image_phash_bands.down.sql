@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_images_phash_b3;
+DROP INDEX IF EXISTS idx_images_phash_b2;
+DROP INDEX IF EXISTS idx_images_phash_b1;
+DROP INDEX IF EXISTS idx_images_phash_b0;
+
+ALTER TABLE images DROP COLUMN IF EXISTS phash_b3;
+ALTER TABLE images DROP COLUMN IF EXISTS phash_b2;
+ALTER TABLE images DROP COLUMN IF EXISTS phash_b1;
+ALTER TABLE images DROP COLUMN IF EXISTS phash_b0;
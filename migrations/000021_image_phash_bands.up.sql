@@ -0,0 +1,12 @@
+-- pHash 16 bitli to'rt bo'lakka bo'linadi. Masofa <= 7 bo'lsa (pigeonhole) kamida bitta bo'lak
+-- ko'pi bilan bitta bitga farq qiladi, shuning uchun nomzodlar indeksdan olinadi va
+-- to'liq Hamming masofasi faqat ular uchun hisoblanadi
+ALTER TABLE images ADD COLUMN IF NOT EXISTS phash_b0 INTEGER GENERATED ALWAYS AS ((phash & 65535)::INTEGER) STORED;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS phash_b1 INTEGER GENERATED ALWAYS AS (((phash >> 16) & 65535)::INTEGER) STORED;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS phash_b2 INTEGER GENERATED ALWAYS AS (((phash >> 32) & 65535)::INTEGER) STORED;
+ALTER TABLE images ADD COLUMN IF NOT EXISTS phash_b3 INTEGER GENERATED ALWAYS AS (((phash >> 48) & 65535)::INTEGER) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_images_phash_b0 ON images (phash_b0) WHERE phash IS NOT NULL AND kind = 'image' AND deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_images_phash_b1 ON images (phash_b1) WHERE phash IS NOT NULL AND kind = 'image' AND deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_images_phash_b2 ON images (phash_b2) WHERE phash IS NOT NULL AND kind = 'image' AND deleted_at = 0;
+CREATE INDEX IF NOT EXISTS idx_images_phash_b3 ON images (phash_b3) WHERE phash IS NOT NULL AND kind = 'image' AND deleted_at = 0;
@@ -0,0 +1,3 @@
+-- searcher - foydalanuvchi ID si yoki IP manzilining kalitli xeshi. Asl qiymat saqlanmaydi,
+-- faqat trend va takliflarda bitta qidiruvchining takroriy so'rovlarini bir marta sanash uchun
+ALTER TABLE search_queries ADD COLUMN IF NOT EXISTS searcher VARCHAR(32) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+-- Faqat ma'lumotlar tuzatildi, sxema o'zgarmagan
@@ -0,0 +1,12 @@
+-- Trashdagi rasmlar muqova bo'lib qolmasligi kerak (DeleteImagesByCarId avval bayroqni tozalamagan)
+UPDATE images SET is_cover = FALSE WHERE is_cover AND deleted_at <> 0;
+
+-- Muqovasi qolmagan e'lonlarda birinchi tirik rasm muqova bo'ladi
+UPDATE images SET is_cover = TRUE
+WHERE id IN (
+    SELECT DISTINCT ON (car_id) id
+    FROM images
+    WHERE kind = 'image' AND deleted_at = 0
+        AND car_id NOT IN (SELECT car_id FROM images WHERE is_cover)
+    ORDER BY car_id, position, uploaded_at
+);
@@ -0,0 +1,3 @@
+-- Sharhlar ham kommentariyalar kabi moderatsiyadan o'tadi. Mavjud sharhlar tasdiqlangan hisoblanadi,
+-- reytinglarga faqat tasdiqlangan sharhlar kiradi
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS moderation_status VARCHAR(20) NOT NULL DEFAULT 'approved';
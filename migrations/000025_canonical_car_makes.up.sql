@@ -0,0 +1,30 @@
+-- Katalog aliaslari qo'shilishidan oldin saqlangan e'lonlarning marka va modeli katalogdagi nomga keltiriladi.
+-- Kalit catalogKey bilan bir xil: kichik harf, faqat harf va raqamlar. To'g'ridan-to'g'ri nom aliasdan ustun
+UPDATE cars c
+SET make = mk.name
+FROM car_makes mk
+WHERE c.make IS DISTINCT FROM mk.name
+    AND mk.id = (
+        SELECT m.id
+        FROM car_makes m
+        WHERE m.name_key = lower(regexp_replace(c.make, '[^[:alnum:]]+', '', 'g'))
+            OR m.id = (SELECT a.make_id FROM car_make_aliases a WHERE a.alias_key = lower(regexp_replace(c.make, '[^[:alnum:]]+', '', 'g')))
+        ORDER BY (m.name_key = lower(regexp_replace(c.make, '[^[:alnum:]]+', '', 'g'))) DESC
+        LIMIT 1
+    );
+
+UPDATE cars c
+SET model = md.name
+FROM car_makes mk
+JOIN car_models md ON md.make_id = mk.id
+WHERE mk.name = c.make
+    AND c.model IS DISTINCT FROM md.name
+    AND md.id = (
+        SELECT m.id
+        FROM car_models m
+        WHERE m.make_id = mk.id
+            AND (m.name_key = lower(regexp_replace(c.model, '[^[:alnum:]]+', '', 'g'))
+                OR m.id IN (SELECT a.model_id FROM car_model_aliases a WHERE a.alias_key = lower(regexp_replace(c.model, '[^[:alnum:]]+', '', 'g'))))
+        ORDER BY (m.name_key = lower(regexp_replace(c.model, '[^[:alnum:]]+', '', 'g'))) DESC, m.name_key
+        LIMIT 1
+    );
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_reports_open_reporter;
+
+-- Eski cheklov uchun har bir foydalanuvchining obyekt bo'yicha eng so'nggi shikoyati qoldiriladi
+DELETE FROM reports r
+USING reports newer
+WHERE newer.entity_type = r.entity_type
+  AND newer.entity_id = r.entity_id
+  AND newer.reporter_id = r.reporter_id
+  AND (newer.created_at, newer.id) > (r.created_at, r.id);
+
+ALTER TABLE reports ADD CONSTRAINT reports_entity_type_entity_id_reporter_id_key
+    UNIQUE (entity_type, entity_id, reporter_id);
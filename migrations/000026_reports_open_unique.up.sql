@@ -0,0 +1,7 @@
+-- Bir foydalanuvchi obyekt haqida faqat bitta ochiq (hal qilinmagan) shikoyat qoldira oladi.
+-- Hal qilingan shikoyat qayta shikoyat qilishga to'sqinlik qilmaydi
+ALTER TABLE reports DROP CONSTRAINT IF EXISTS reports_entity_type_entity_id_reporter_id_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reports_open_reporter
+    ON reports (entity_type, entity_id, reporter_id)
+    WHERE status <> 'resolved';
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIPFromContext(t *testing.T) {
+	s := &CarService{trustedProxies: parseTrustedProxies("127.0.0.0/8,::1/128,10.0.0.0/8", slog.New(slog.NewTextHandler(io.Discard, nil)))}
+
+	tests := []struct {
+		name string
+		peer string
+		xff  []string
+		want string
+	}{
+		{"no forwarded header", "203.0.113.7:5000", nil, "203.0.113.7"},
+		{"untrusted peer spoofing header", "203.0.113.7:5000", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"gateway on loopback", "127.0.0.1:5000", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"client-supplied hop is skipped", "127.0.0.1:5000", []string{"1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"trusted balancer in chain", "127.0.0.1:5000", []string{"1.2.3.4, 198.51.100.1, 10.0.0.5"}, "198.51.100.1"},
+		{"garbage hop stops the walk", "127.0.0.1:5000", []string{"198.51.100.1, not-an-ip"}, "127.0.0.1"},
+		{"ipv6 loopback gateway", "[::1]:5000", []string{"2001:db8::1"}, "2001:db8::1"},
+		{"ipv4-mapped peer", "[::ffff:203.0.113.7]:5000", []string{"198.51.100.1"}, "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.peer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			if tt.xff != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", tt.xff[0]))
+			}
+			if got := s.clientIPFromContext(ctx); got != tt.want {
+				t.Fatalf("clientIPFromContext = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,18 +3,22 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"net/netip"
+	"strings"
 	"time"
+	"wegugin/config"
 	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
 	"wegugin/storage/postgres"
 	"wegugin/storage/postgres/sqlc"
 
-	firebase "firebase.google.com/go/v4"
-	"firebase.google.com/go/v4/messaging"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	zero "gopkg.in/guregu/null.v4/zero"
@@ -22,14 +26,47 @@ import (
 
 type CarService struct {
 	pb.UnimplementedCrudsServiceServer
-	logger *slog.Logger
-	store  postgres.Store
+	logger               *slog.Logger
+	store                postgres.Store
+	moderationMode       string
+	reportHideThreshold  int
+	trendingWindow       time.Duration
+	trendingMinSearchers int32
+	searcherSecret       []byte
+	filesBaseURL         string
+	signer               blob.Signer
+	signedURLTTL         time.Duration
+	commentMaxDepth      int
+	trustedProxies       []netip.Prefix
 }
 
+// errNotInTrash - tiklanadigan yozuv o'chirilganlar orasida topilmadi
+var errNotInTrash = errors.New("record is not in trash")
+
+const (
+	carDeletedNotificationType    = "car_deleted"
+	carDeletedNotificationMessage = "A car you saved has been removed by its owner"
+)
+
 func NewService(store postgres.Store, logger *slog.Logger) *CarService {
+	cfg := config.Load()
+	signer, err := blob.NewSigner(cfg.Blob)
+	if err != nil {
+		logger.Error("signed URLs disabled", "error", err)
+	}
 	return &CarService{
-		store:  store,
-		logger: logger,
+		store:                store,
+		logger:               logger,
+		moderationMode:       cfg.Moderation.MODERATION_MODE,
+		reportHideThreshold:  cfg.Reports.REPORT_HIDE_THRESHOLD,
+		trendingWindow:       cfg.Search.SEARCH_TRENDING_WINDOW,
+		trendingMinSearchers: int32(cfg.Search.SEARCH_TRENDING_MIN_SEARCHERS),
+		searcherSecret:       []byte(cfg.Token.TOKEN_KEY),
+		filesBaseURL:         cfg.Blob.BLOB_PUBLIC_URL,
+		signer:               signer,
+		signedURLTTL:         cfg.Blob.SIGNED_URL_TTL,
+		commentMaxDepth:      cfg.Comments.COMMENT_MAX_DEPTH,
+		trustedProxies:       parseTrustedProxies(cfg.Server.TRUSTED_PROXIES, logger),
 	}
 }
 
@@ -54,11 +91,31 @@ func (s *CarService) CreateCar(ctx context.Context, req *pb.CreateCarRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid price value")
 	}
 
-	// 4. SQL parametrlari
+	// 4. Marka va modelni katalog bo'yicha normallashtirish, tarjimalarni tekshirish
+	carMake, model := s.normalizeMakeModel(ctx, req.GetMake(), req.GetModel())
+	descriptions, err := validateDescriptions(req.GetDescriptions())
+	if err != nil {
+		return nil, err
+	}
+
+	// Tashkilot nomidan joylashtirish uchun yaratuvchi uning a'zosi bo'lishi kerak
+	var orgID pgtype.UUID
+	if req.GetOrganizationId() != "" {
+		orgUUID, err := uuid.Parse(req.GetOrganizationId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid organization ID format")
+		}
+		orgID = pgtype.UUID{Bytes: orgUUID, Valid: true}
+		if _, err := s.orgMemberRole(ctx, orgID, pgtype.UUID{Bytes: ownerUUID, Valid: true}); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. SQL parametrlari
 	arg := sqlc.CreateCarParams{
 		Type:        zero.StringFrom(req.GetType()),
-		Make:        zero.StringFrom(req.GetMake()),
-		Model:       zero.StringFrom(req.GetModel()),
+		Make:        zero.StringFrom(carMake),
+		Model:       zero.StringFrom(model),
 		Year:        pgtype.Int4{Int32: req.GetYear(), Valid: req.GetYear() != 0},
 		Color:       zero.StringFrom(req.GetColor()),
 		Mileage:     pgtype.Int4{Int32: req.GetMileage(), Valid: req.GetMileage() != 0},
@@ -68,15 +125,51 @@ func (s *CarService) CreateCar(ctx context.Context, req *pb.CreateCarRequest) (*
 		Location:    zero.StringFrom(req.GetLocation()),
 	}
 
-	// 5. Ma'lumotlar bazasiga saqlash
-	dbCar, err := s.store.CreateCar(ctx, arg)
+	// 6. Ma'lumotlar bazasiga saqlash, moderatsiya navbatiga qo'yish va audit
+	var car *pb.Car
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbCar, err := q.CreateCar(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("create car: %w", err)
+		}
+		carUUID, err := uuid.Parse(dbCar.ID)
+		if err != nil {
+			return fmt.Errorf("parse car id: %w", err)
+		}
+		carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+		// Sotuvda bo'lmagan e'lon faol e'lonlar kvotasiga kirmaydi (CountActiveListings kabi)
+		if dbCar.Available.Bool {
+			if err := enforceListingQuota(ctx, q, arg.OwnerID, carID); err != nil {
+				return err
+			}
+		}
+		if err := saveDescriptions(ctx, q, carID, descriptions); err != nil {
+			return err
+		}
+		if orgID.Valid {
+			if err := q.SetCarOrganization(ctx, sqlc.SetCarOrganizationParams{OrganizationID: orgID, ID: carID}); err != nil {
+				return fmt.Errorf("set car organization: %w", err)
+			}
+		}
+		if err := s.enqueueModeration(ctx, q, entityCar, carID, arg.OwnerID); err != nil {
+			return err
+		}
+
+		car = s.convertDBCarToProto(dbCar)
+		car.ModerationStatus = s.initialModerationStatus()
+		car.OrganizationId = req.GetOrganizationId()
+		return s.audit(ctx, q, "CreateCar", entityCar, car.Id, nil, car)
+	})
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	if err != nil {
 		s.logger.Error("failed to create car", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create car")
 	}
 
-	// 6. Protobuf response
-	return s.convertDBCarToProto(dbCar), nil
+	// 7. Protobuf response
+	return car, nil
 }
 
 func (s *CarService) GetCarById(ctx context.Context, req *pb.Id) (*pb.Car, error) {
@@ -91,7 +184,28 @@ func (s *CarService) GetCarById(ctx context.Context, req *pb.Id) (*pb.Car, error
 		return nil, status.Error(codes.NotFound, "car not found")
 	}
 
-	return s.convertDBCarToProtoWithImages(dbCar), nil
+	// Tasdiqlanmagan e'lon boshqalar uchun mavjud emasdek ko'rinadi
+	if dbCar.ModerationStatus != moderationApproved && !canViewUnmoderated(ctx, dbCar.OwnerID) {
+		return nil, status.Error(codes.NotFound, "car not found")
+	}
+
+	car := s.convertDBCarToProtoWithImages(dbCar)
+	s.setPriceBadge(ctx, car)
+
+	// Tarjimalar va Accept-Language bo'yicha tavsif
+	translations, err := s.store.GetCarDescriptions(ctx, pgtype.UUID{Bytes: carID, Valid: true})
+	if err != nil {
+		s.logger.Warn("failed to load car descriptions", "car_id", car.Id, "error", err)
+	}
+	if len(translations) > 0 {
+		car.Descriptions = make(map[string]string, len(translations))
+		for _, t := range translations {
+			car.Descriptions[t.Locale] = t.Description
+		}
+		applyDescription(car, preferredLocales(ctx), car.Descriptions)
+	}
+
+	return car, nil
 }
 
 func (s *CarService) ListCars(ctx context.Context, req *pb.ListCarsRequest) (*pb.ListCarsResponse, error) {
@@ -137,6 +251,8 @@ func (s *CarService) ListCars(ctx context.Context, req *pb.ListCarsRequest) (*pb
 	for i, dbCar := range dbCars {
 		cars[i] = s.convertListCarToProto(dbCar)
 	}
+	s.setPriceBadges(ctx, cars)
+	s.localizeCars(ctx, cars)
 
 	return &pb.ListCarsResponse{Cars: cars}, nil
 }
@@ -153,12 +269,18 @@ func (s *CarService) UpdateCar(ctx context.Context, req *pb.UpdateCarRequest) (*
 		return nil, status.Error(codes.InvalidArgument, "invalid price value")
 	}
 
-	// 3. SQL parametrlari
+	// 3. SQL parametrlari (marka va model katalog bo'yicha normallashtiriladi)
+	carMake, model := s.normalizeMakeModel(ctx, req.GetMake(), req.GetModel())
+	descriptions, err := validateDescriptions(req.GetDescriptions())
+	if err != nil {
+		return nil, err
+	}
 	carID, _ := uuid.Parse(req.GetId())
+	before := s.carSnapshot(ctx, s.store, pgtype.UUID{Bytes: carID, Valid: true})
 	arg := sqlc.UpdateCarParams{
 		Type:        zero.StringFrom(req.GetType()),
-		Make:        zero.StringFrom(req.GetMake()),
-		Model:       zero.StringFrom(req.GetModel()),
+		Make:        zero.StringFrom(carMake),
+		Model:       zero.StringFrom(model),
 		Year:        pgtype.Int4{Int32: req.GetYear(), Valid: req.GetYear() != 0},
 		Color:       zero.StringFrom(req.GetColor()),
 		Mileage:     pgtype.Int4{Int32: req.GetMileage(), Valid: req.GetMileage() != 0},
@@ -169,8 +291,35 @@ func (s *CarService) UpdateCar(ctx context.Context, req *pb.UpdateCarRequest) (*
 		ID:          pgtype.UUID{Bytes: carID, Valid: true},
 	}
 
-	// 4. Ma'lumotlarni yangilash
-	if err := s.store.UpdateCar(ctx, arg); err != nil {
+	// 4. Ma'lumotlarni va tarjimalarni yangilash. Sotuvdan olingan e'lonni qayta
+	// faollashtirish reja chegarasiga qarab tekshiriladi
+	republish := before != nil && !before.Available && req.GetAvailable()
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if republish {
+			ownerUUID, err := uuid.Parse(before.OwnerId)
+			if err != nil {
+				return fmt.Errorf("parse owner id: %w", err)
+			}
+			if err := enforceListingQuota(ctx, q, pgtype.UUID{Bytes: ownerUUID, Valid: true}, arg.ID); err != nil {
+				return err
+			}
+		}
+		if err := q.UpdateCar(ctx, arg); err != nil {
+			return fmt.Errorf("update car: %w", err)
+		}
+		if err := saveDescriptions(ctx, q, arg.ID, descriptions); err != nil {
+			return err
+		}
+		// Rad etilgan, o'zgartirish so'ralgan yoki (pre-moderatsiyada) tasdiqlangan e'lon qayta moderatsiyaga yuboriladi
+		if err := s.resubmitForModeration(ctx, q, entityCar, arg.ID, actorIDFromContext(ctx)); err != nil {
+			return fmt.Errorf("resubmit for moderation: %w", err)
+		}
+		return s.audit(ctx, q, "UpdateCar", entityCar, req.GetId(), before, s.carSnapshot(ctx, q, arg.ID))
+	})
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
 		s.logger.Error("failed to update car", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update car")
 	}
@@ -179,8 +328,8 @@ func (s *CarService) UpdateCar(ctx context.Context, req *pb.UpdateCarRequest) (*
 }
 
 func (s *CarService) DeleteCar(ctx context.Context, req *pb.Id) (*pb.Empty, error) {
-	// 1. Tegishlik tekshiruvi
-	if err := s.checkCarOwnership(ctx, req.GetId()); err != nil {
+	// 1. Tegishlik tekshiruvi (tashkilot e'loni uchun owner yoki manager)
+	if err := s.checkCarAccess(ctx, req.GetId(), carActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -190,12 +339,120 @@ func (s *CarService) DeleteCar(ctx context.Context, req *pb.Id) (*pb.Empty, erro
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
-	// 3. O'chirish
-	if err := s.store.DeleteCar(ctx, pgtype.UUID{Bytes: carID, Valid: true}); err != nil {
+	// 3. Mashina va unga bog'liq ma'lumotlarni tranzaksiya ichida o'chirish (soft delete).
+	// Saqlanganlar ro'yxati o'chirilmaydi: mashina o'chirilgan paytda u yashiriladi,
+	// tiklanganda esa qaytadi. Purge job ularni mashina bilan birga tozalaydi.
+	id := pgtype.UUID{Bytes: carID, Valid: true}
+	before := s.carSnapshot(ctx, s.store, id)
+	var savedBy []string
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		savedBy, err = q.GetSavedCarUsersByCarId(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get saved car users: %w", err)
+		}
+		if err := q.DeleteImagesByCarId(ctx, id); err != nil {
+			return fmt.Errorf("delete images: %w", err)
+		}
+		if err := q.DeleteCommentsByCarId(ctx, id); err != nil {
+			return fmt.Errorf("delete comments: %w", err)
+		}
+		if err := q.DeleteCar(ctx, id); err != nil {
+			return fmt.Errorf("delete car: %w", err)
+		}
+
+		// Saqlab qo'ygan foydalanuvchilar uchun bildirishnoma
+		for _, userID := range savedBy {
+			userUUID, err := uuid.Parse(userID)
+			if err != nil {
+				return fmt.Errorf("parse user id: %w", err)
+			}
+			if _, err := q.CreateNotification(ctx, sqlc.CreateNotificationParams{
+				UserID:  pgtype.UUID{Bytes: userUUID, Valid: true},
+				Type:    zero.StringFrom(carDeletedNotificationType),
+				Message: zero.StringFrom(carDeletedNotificationMessage),
+			}); err != nil {
+				return fmt.Errorf("create notification: %w", err)
+			}
+		}
+		return s.audit(ctx, q, "DeleteCar", entityCar, req.GetId(), before, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete car", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete car")
 	}
 
+	// 4. Push xabarlar (tranzaksiyadan keyin, xatolar faqat log qilinadi)
+	for _, userID := range savedBy {
+		userUUID, err := uuid.Parse(userID)
+		if err != nil {
+			continue
+		}
+		if err := s.sendPushNotification(ctx, userUUID, carDeletedNotificationType, carDeletedNotificationMessage); err != nil {
+			s.logger.Warn("failed to push car deleted notification", "user_id", userID, "error", err)
+		}
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// RestoreCar - O'chirilgan mashinani (rasmlari va kommentariyalari bilan) tiklash
+func (s *CarService) RestoreCar(ctx context.Context, req *pb.Id) (*pb.Empty, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarAccess(ctx, req.GetId(), carActionDelete); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. UUID konvertatsiyasi
+	carID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+
+	// 3. Tiklash. Rasmlar va kommentariyalar mashinadan oldin tiklanadi,
+	// chunki ular mashinaning deleted_at qiymati bo'yicha tanlanadi
+	id := pgtype.UUID{Bytes: carID, Valid: true}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		// Tiklangan e'lon yana faol bo'ladi, shuning uchun egasining rejasi tekshiriladi
+		ownerID, err := q.GetCarOwnerId(ctx, id)
+		if err != nil {
+			return errNotInTrash
+		}
+		ownerUUID, err := uuid.Parse(ownerID)
+		if err != nil {
+			return fmt.Errorf("parse owner id: %w", err)
+		}
+		if err := enforceListingQuota(ctx, q, pgtype.UUID{Bytes: ownerUUID, Valid: true}, id); err != nil {
+			return err
+		}
+		if err := q.RestoreImagesByCarId(ctx, id); err != nil {
+			return fmt.Errorf("restore images: %w", err)
+		}
+		if err := q.RestoreCommentsByCarId(ctx, id); err != nil {
+			return fmt.Errorf("restore comments: %w", err)
+		}
+		restored, err := q.RestoreCar(ctx, id)
+		if err != nil {
+			return fmt.Errorf("restore car: %w", err)
+		}
+		if restored == 0 {
+			return errNotInTrash
+		}
+		return s.audit(ctx, q, "RestoreCar", entityCar, req.GetId(), nil, s.carSnapshot(ctx, q, id))
+	})
+	if errors.Is(err, errNotInTrash) {
+		return nil, status.Error(codes.NotFound, "deleted car not found")
+	}
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to restore car", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore car")
+	}
+
 	return &pb.Empty{}, nil
 }
 
@@ -206,7 +463,13 @@ func (s *CarService) IncrementCarReviewCount(ctx context.Context, req *pb.Id) (*
 	}
 
 	// 3. O'chirish
-	if err := s.store.IncrementCarReviewCount(ctx, pgtype.UUID{Bytes: carID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.IncrementCarReviewCount(ctx, pgtype.UUID{Bytes: carID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "IncrementCarReviewCount", entityCar, req.GetId(), nil, map[string]int{"reviews_count_increment": 1})
+	})
+	if err != nil {
 		s.logger.Error("failed to increment car review count", "error", err)
 		return nil, status.Error(codes.Internal, "failed to increment car review count")
 	}
@@ -252,10 +515,17 @@ func (s *CarService) SearchCar(ctx context.Context, req *pb.SearchCarRequest) (*
 		return nil, status.Error(codes.Internal, "failed to search cars")
 	}
 
+	// Faqat birinchi sahifa jurnalga yoziladi, aks holda sahifalash so'rovni ko'paytirib yuboradi
+	if req.GetOffset() == 0 {
+		s.logSearchQuery(ctx, req.GetQuery(), len(dbCars))
+	}
+
 	cars := make([]*pb.Car, len(dbCars))
 	for i, dbCar := range dbCars {
 		cars[i] = s.convertSearchCarToProto(dbCar)
 	}
+	s.setPriceBadges(ctx, cars)
+	s.localizeCars(ctx, cars)
 
 	return &pb.ListCarsResponse{Cars: cars}, nil
 }
@@ -277,10 +547,22 @@ func (s *CarService) SaveCar(ctx context.Context, req *pb.SaveCarRequest) (*pb.E
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
-	// 3. Ma'lumotlar bazasiga saqlash
-	_, err = s.store.CreateSavedCar(ctx, sqlc.CreateSavedCarParams{
-		UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
-		CarID:  pgtype.UUID{Bytes: carUUID, Valid: true},
+	// 3. Ma'lumotlar bazasiga saqlash va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		saved, err := q.CreateSavedCar(ctx, sqlc.CreateSavedCarParams{
+			UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
+			CarID:  pgtype.UUID{Bytes: carUUID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "SaveCar", entitySavedCar, saved.ID, nil, &pb.SavedCar{
+			Id:        saved.ID,
+			UserId:    saved.UserID,
+			CarId:     saved.CarID,
+			CreatedAt: saved.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt: saved.UpdatedAt.Time.Format(time.RFC3339),
+		})
 	})
 	if err != nil {
 		s.logger.Error("failed to save car", "error", err)
@@ -334,7 +616,13 @@ func (s *CarService) DeleteSavedCar(ctx context.Context, req *pb.DeleteSavedCarR
 	}
 
 	// 3. O'chirish
-	if err := s.store.DeleteSavedCar(ctx, pgtype.UUID{Bytes: savedCarUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteSavedCar(ctx, pgtype.UUID{Bytes: savedCarUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteSavedCar", entitySavedCar, req.GetId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete saved car", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete saved car")
 	}
@@ -351,7 +639,13 @@ func (s *CarService) DeleteSavedCarsByCarId(ctx context.Context, req *pb.CarId)
 	}
 
 	// 2. O'chirish
-	if err := s.store.DeleteSavedCarsByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteSavedCarsByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteSavedCarsByCarId", entityCar, req.GetCarId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete saved cars by car ID", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete saved cars")
 	}
@@ -388,56 +682,15 @@ func (s *CarService) CheckSavedCarOwnership(ctx context.Context, req *pb.BoolChe
 
 // CreateNotification - Yangi bildirishnoma yaratish
 func (s *CarService) CreateNotification(ctx context.Context, req *pb.CreateNotificationRequest) (*pb.Empty, error) {
-	// 2. UUID konvertatsiyasi
+	// 1. UUID konvertatsiyasi
 	userUUID, err := uuid.Parse(req.GetUserId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
 	}
 
-	// 1. Autentifikatsiya
-	config := &firebase.Config{
-		ProjectID: "wegugin-cars-notifications",
-	}
-
-	// Firebase ilovasini ishga tushirish
-	opt := option.WithCredentialsFile("./firebase/wegugin-cars-notifications-firebase-adminsdk-fbsvc-0016bd1639.json")
-	app, err := firebase.NewApp(context.Background(), config, opt)
-	if err != nil {
-		s.logger.Error(fmt.Sprintf("Ilovani ishga tushirishda xatolik: %v", err))
-		return nil, status.Error(codes.Internal, "failed to initialize Firebase app")
-	}
-	// FCM mijozini olish
-	client, err := app.Messaging(context.Background())
-	if err != nil {
-		s.logger.Error(fmt.Sprintf("FCM mijozini olishda xatolik: %v", err))
-		return nil, status.Error(codes.Internal, "failed to initialize FCM client")
-	}
-	resptoken, err := s.store.GetNotificationTokensByUserId(ctx, pgtype.UUID{Bytes: userUUID, Valid: true})
-	if err != nil {
-		s.logger.Error("Failed to get notification tokens", "error", err)
-		return nil, status.Error(codes.Internal, "failed to retrieve notification tokens")
-	}
-
-	for _, v := range resptoken {
-		// Xabar yaratish
-		message := &messaging.Message{
-			Notification: &messaging.Notification{
-				Title: req.GetType(),
-				Body:  req.GetMessage(),
-			},
-			Token: v.Token,
-		}
-
-		go func(token string) {
-			_, err := client.Send(context.Background(), message)
-			if err != nil {
-				// Faqatgina xatolarni log qilamiz, lekin jarayonni to'xtatmaymiz
-				s.logger.Error("FCM xabar yuborishda xato",
-					"token", token,
-					"error", err,
-				)
-			}
-		}(v.Token)
+	// 2. Push xabar yuborish
+	if err := s.sendPushNotification(ctx, userUUID, req.GetType(), req.GetMessage()); err != nil {
+		return nil, err
 	}
 
 	// 3. SQL parametrlari
@@ -447,8 +700,14 @@ func (s *CarService) CreateNotification(ctx context.Context, req *pb.CreateNotif
 		Message: zero.StringFrom(req.GetMessage()),
 	}
 
-	// 4. Ma'lumotlar bazasiga yozish
-	_, err = s.store.CreateNotification(ctx, arg)
+	// 4. Ma'lumotlar bazasiga yozish va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		notif, err := q.CreateNotification(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "CreateNotification", entityNotification, notif.ID, nil, s.convertDBNotificationToProto(notif))
+	})
 	if err != nil {
 		s.logger.Error("failed to create notification", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create notification")
@@ -514,7 +773,13 @@ func (s *CarService) MarkNotificationAsRead(ctx context.Context, req *pb.MarkNot
 	}
 
 	// 3. Yangilash
-	if err := s.store.MarkNotificationAsRead(ctx, pgtype.UUID{Bytes: notifUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.MarkNotificationAsRead(ctx, pgtype.UUID{Bytes: notifUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "MarkNotificationAsRead", entityNotification, req.GetId(), nil, map[string]bool{"seen": true})
+	})
+	if err != nil {
 		s.logger.Error("failed to mark notification as read", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update notification")
 	}
@@ -531,7 +796,13 @@ func (s *CarService) DeleteNotification(ctx context.Context, req *pb.DeleteNotif
 	}
 
 	// 3. O'chirish
-	if err := s.store.DeleteNotification(ctx, pgtype.UUID{Bytes: notifUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteNotification(ctx, pgtype.UUID{Bytes: notifUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteNotification", entityNotification, req.GetId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete notification", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete notification")
 	}
@@ -539,6 +810,43 @@ func (s *CarService) DeleteNotification(ctx context.Context, req *pb.DeleteNotif
 	return &pb.Empty{}, nil
 }
 
+// RestoreNotification - O'chirilgan bildirishnomani tiklash
+func (s *CarService) RestoreNotification(ctx context.Context, req *pb.RestoreNotificationRequest) (*pb.Empty, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkNotificationOwnership(ctx, req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. UUID konvertatsiyasi
+	notifUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid notification ID format")
+	}
+
+	// 3. Tiklash
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		restored, err := q.RestoreNotification(ctx, pgtype.UUID{Bytes: notifUUID, Valid: true})
+		if err != nil {
+			return err
+		}
+		if restored == 0 {
+			return errNotInTrash
+		}
+		return s.audit(ctx, q, "RestoreNotification", entityNotification, req.GetId(), nil, nil)
+	})
+	if errors.Is(err, errNotInTrash) {
+		return nil, status.Error(codes.NotFound, "deleted notification not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to restore notification", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore notification")
+	}
+
+	return &pb.Empty{}, nil
+}
+
 // ---------------------- MESSAGES ----------------------
 
 func (s *CarService) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.Message, error) {
@@ -551,20 +859,58 @@ func (s *CarService) SendMessage(ctx context.Context, req *pb.SendMessageRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid recipient ID format")
 	}
 
+	// 2. E'lon bo'yicha yozishma bo'lsa, tashkilot a'zolari ham unga javob bera oladi
+	var carID pgtype.UUID
+	if req.GetCarId() != "" {
+		carUUID, err := uuid.Parse(req.GetCarId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+		}
+		carID = pgtype.UUID{Bytes: carUUID, Valid: true}
+	}
+	senderID := pgtype.UUID{Bytes: senderUUID, Valid: true}
+	recipientID := pgtype.UUID{Bytes: recipientUUID, Valid: true}
+
+	// car_id orqali xabar tashkilot a'zolariga ochiladi, shuning uchun e'lon
+	// yuboruvchi yoki qabul qiluvchiga (yoki ularning tashkilotiga) tegishli bo'lishi shart
+	if carID.Valid {
+		related, err := s.store.CheckMessageCarParticipant(ctx, sqlc.CheckMessageCarParticipantParams{
+			CarID:       carID,
+			SenderID:    senderID,
+			RecipientID: recipientID,
+		})
+		if err != nil {
+			s.logger.Error("failed to check message car", "error", err)
+			return nil, status.Error(codes.Internal, "failed to send message")
+		}
+		if !related {
+			return nil, status.Error(codes.InvalidArgument, "car is not related to this conversation")
+		}
+	}
+
 	// 3. Xabarni yaratish
 	arg := sqlc.CreateMessageParams{
-		SenderID:    pgtype.UUID{Bytes: senderUUID, Valid: true},
-		RecipientID: pgtype.UUID{Bytes: recipientUUID, Valid: true},
+		SenderID:    senderID,
+		RecipientID: recipientID,
 		Content:     zero.StringFrom(req.GetContent()),
+		CarID:       carID,
 	}
 
-	dbMessage, err := s.store.CreateMessage(ctx, arg)
+	var message *pb.Message
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbMessage, err := q.CreateMessage(ctx, arg)
+		if err != nil {
+			return err
+		}
+		message = s.convertDBMessageToProto(dbMessage)
+		return s.audit(ctx, q, "SendMessage", entityMessage, message.Id, nil, message)
+	})
 	if err != nil {
 		s.logger.Error("failed to send message", "error", err)
 		return nil, status.Error(codes.Internal, "failed to send message")
 	}
 
-	return s.convertDBMessageToProto(dbMessage), nil
+	return message, nil
 }
 
 func (s *CarService) GetMessagesByUser(ctx context.Context, req *pb.GetMessagesByUserRequest) (*pb.ListMessagesResponse, error) {
@@ -665,7 +1011,13 @@ func (s *CarService) MarkMessageAsRead(ctx context.Context, req *pb.MessageId) (
 	}
 
 	// 3. Yangilash
-	if err := s.store.MarkMessageAsRead(ctx, pgtype.UUID{Bytes: msgUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.MarkMessageAsRead(ctx, pgtype.UUID{Bytes: msgUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "MarkMessageAsRead", entityMessage, req.GetId(), nil, map[string]bool{"read": true})
+	})
+	if err != nil {
 		s.logger.Error("failed to mark message as read", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update message")
 	}
@@ -682,7 +1034,13 @@ func (s *CarService) DeleteMessage(ctx context.Context, req *pb.DeleteMessageReq
 	}
 
 	// 3. O'chirish
-	if err := s.store.DeleteMessage(ctx, pgtype.UUID{Bytes: msgUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteMessage(ctx, pgtype.UUID{Bytes: msgUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteMessage", entityMessage, req.GetId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete message", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete message")
 	}
@@ -742,8 +1100,17 @@ func (s *CarService) RegisterNotificationToken(ctx context.Context, req *pb.Regi
 		Platform: zero.StringFrom(req.Platform),
 	}
 
-	// 5. Bazaga yozish
-	_, err = s.store.CreateNotificationToken(ctx, arg)
+	// 5. Bazaga yozish va audit (tokenning o'zi saqlanmaydi)
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		token, err := q.CreateNotificationToken(ctx, arg)
+		if err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "RegisterNotificationToken", entityNotificationToken, token.ID, nil, map[string]string{
+			"user_id":  token.UserID,
+			"platform": token.Platform,
+		})
+	})
 	if err != nil {
 		s.logger.Error("failed to register token", "error", err)
 		return nil, status.Error(codes.Internal, "failed to register token")
@@ -789,7 +1156,13 @@ func (s *CarService) DeleteNotificationToken(ctx context.Context, req *pb.Delete
 		return nil, status.Error(codes.InvalidArgument, "invalid token ID format")
 	}
 
-	if err := s.store.DeleteNotificationToken(ctx, pgtype.UUID{Bytes: tokenUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteNotificationToken(ctx, pgtype.UUID{Bytes: tokenUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteNotificationToken", entityNotificationToken, req.GetTokenId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete token", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete token")
 	}
@@ -800,28 +1173,91 @@ func (s *CarService) DeleteNotificationToken(ctx context.Context, req *pb.Delete
 // ---------------------- IMAGES ----------------------
 
 func (s *CarService) AddImage(ctx context.Context, req *pb.AddImageRequest) (*pb.Image, error) {
+	// Rasmni faqat e'lon egasi, tahrirlash huquqi bor tashkilot a'zosi yoki admin qo'shadi
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+			return nil, err
+		}
+	}
 	carUUID, err := uuid.Parse(req.GetCarId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
+	// O'lchamlar yuklash handleri tomonidan asl fayl yoniga saqlanadi
+	variants := []string{}
+	for _, v := range req.GetVariants() {
+		if !blob.IsVariant(v) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown image variant %q", v))
+		}
+		variants = append(variants, v)
+	}
+
 	arg := sqlc.AddImageParams{
 		CarID:    pgtype.UUID{Bytes: carUUID, Valid: true},
 		Filename: zero.StringFrom(req.GetFilename()),
+		Variants: variants,
+		Checksum: zero.StringFrom(req.GetChecksum()),
+	}
+	// Rasm ma'lumotlari yuklash handleri tekshirgan fayldan keladi, bo'lmasa NULL qoladi
+	if req.GetWidth() < 0 || req.GetHeight() < 0 || req.GetSizeBytes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "image dimensions and size must not be negative")
+	}
+	if req.GetWidth() > 0 && req.GetHeight() > 0 {
+		arg.Width = pgtype.Int4{Int32: req.GetWidth(), Valid: true}
+		arg.Height = pgtype.Int4{Int32: req.GetHeight(), Valid: true}
+	}
+	if req.GetSizeBytes() > 0 {
+		arg.SizeBytes = pgtype.Int8{Int64: req.GetSizeBytes(), Valid: true}
+	}
+	if req.GetPhash() != 0 {
+		// BIGINT ustunida bitlar o'zgarmasdan saqlanadi
+		arg.Phash = pgtype.Int8{Int64: int64(req.GetPhash()), Valid: true}
+	}
+
+	// Rasmlar soni e'lon egasining rejasi bo'yicha cheklanadi
+	dbCar, err := s.store.GetCarById(ctx, arg.CarID)
+	if err != nil {
+		s.logger.Error("car not found", "error", err)
+		return nil, status.Error(codes.NotFound, "car not found")
+	}
+	ownerUUID, err := uuid.Parse(dbCar.OwnerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid owner ID")
 	}
 
-	dbImage, err := s.store.AddImage(ctx, arg)
+	ownerID := pgtype.UUID{Bytes: ownerUUID, Valid: true}
+	var image *pb.Image
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := enforceImageQuota(ctx, q, arg.CarID, ownerID); err != nil {
+			return err
+		}
+		dbImage, err := q.AddImage(ctx, arg)
+		if err != nil {
+			return err
+		}
+		image = s.convertDBImageToProto(dbImage)
+		if arg.Phash.Valid {
+			// Boshqa egalarning e'lonlaridagi deyarli bir xil rasmlar moderatsiyaga belgilanadi
+			if image.PossibleDuplicate, err = flagDuplicateImage(ctx, q, dbImage, ownerID, arg.Phash.Int64); err != nil {
+				return err
+			}
+		}
+		return s.audit(ctx, q, "AddImage", entityImage, image.Id, nil, image)
+	})
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	if err != nil {
 		s.logger.Error("failed to add image", "error", err)
 		return nil, status.Error(codes.Internal, "failed to upload image")
 	}
 
-	return &pb.Image{
-		Id:         dbImage.ID,
-		CarId:      dbImage.CarID,
-		Filename:   dbImage.Filename,
-		UploadedAt: dbImage.UploadedAt.Time.Format(time.RFC3339),
-	}, nil
+	if image.PossibleDuplicate {
+		s.logger.Warn("possible duplicate image uploaded", "image_id", image.Id, "car_id", image.CarId)
+	}
+
+	return image, nil
 }
 
 // GetImagesByCar - Avtomobil rasmlarini olish
@@ -842,12 +1278,7 @@ func (s *CarService) GetImagesByCar(ctx context.Context, req *pb.CarId) (*pb.Lis
 	// 3. Konvertatsiya
 	images := make([]*pb.Image, len(dbImages))
 	for i, dbImg := range dbImages {
-		images[i] = &pb.Image{
-			Id:         dbImg.ID,
-			CarId:      dbImg.CarID,
-			Filename:   dbImg.Filename,
-			UploadedAt: dbImg.UploadedAt.Time.Format(time.RFC3339),
-		}
+		images[i] = s.convertDBImageToProto(dbImg)
 	}
 
 	return &pb.ListImagesResponse{Images: images}, nil
@@ -855,14 +1286,38 @@ func (s *CarService) GetImagesByCar(ctx context.Context, req *pb.CarId) (*pb.Lis
 
 // DeleteImage - Rasmni o'chirish
 func (s *CarService) DeleteImage(ctx context.Context, req *pb.ImageId) (*pb.Empty, error) {
-	// 1. Rasmni bazadan olish
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkImageOwnership(ctx, req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Rasmni bazadan olish
 	imageUUID, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
 	}
 
-	// 2. O'chirish
-	if err := s.store.DeleteImage(ctx, pgtype.UUID{Bytes: imageUUID, Valid: true}); err != nil {
+	// 3. O'chirish
+	var before *pb.Image
+	dbImage, err := s.store.GetImageById(ctx, pgtype.UUID{Bytes: imageUUID, Valid: true})
+	if err == nil {
+		before = s.convertDBImageToProto(dbImage)
+	}
+	// Muqova o'chirilsa, keyingi rasm muqova bo'ladi
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteImage(ctx, pgtype.UUID{Bytes: imageUUID, Valid: true}); err != nil {
+			return err
+		}
+		if carUUID, err := uuid.Parse(dbImage.CarID); before != nil && err == nil {
+			if err := q.EnsureCoverImage(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+				return err
+			}
+		}
+		return s.audit(ctx, q, "DeleteImage", entityImage, req.GetId(), before, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete image", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete image")
 	}
@@ -872,12 +1327,25 @@ func (s *CarService) DeleteImage(ctx context.Context, req *pb.ImageId) (*pb.Empt
 
 // DeleteImagesByCarId - Avtomobil rasmlarini o'chirish
 func (s *CarService) DeleteImagesByCarId(ctx context.Context, req *pb.CarId) (*pb.Empty, error) {
+	// Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+			return nil, err
+		}
+	}
+
 	carUUID, err := uuid.Parse(req.GetCarId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
-	if err := s.store.DeleteImagesByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteImagesByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteImagesByCarId", entityCar, req.GetCarId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete images", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete images")
 	}
@@ -885,29 +1353,437 @@ func (s *CarService) DeleteImagesByCarId(ctx context.Context, req *pb.CarId) (*p
 	return &pb.Empty{}, nil
 }
 
-// GetImagesByCar - Avtomobil rasmlarini olish
-func (s *CarService) GetImageByID(ctx context.Context, req *pb.ImageId) (*pb.Image, error) {
-	// 1. UUID konvertatsiyasi
-	imageID, err := uuid.Parse(req.GetId())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
+// RestoreImage - O'chirilgan rasmni tiklash
+func (s *CarService) RestoreImage(ctx context.Context, req *pb.ImageId) (*pb.Empty, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkImageOwnership(ctx, req.GetId()); err != nil {
+			return nil, err
+		}
 	}
 
-	// 2. Ma'lumotlarni olish
-	dbImage, err := s.store.GetImageById(ctx, pgtype.UUID{Bytes: imageID, Valid: true})
+	// 2. UUID konvertatsiyasi
+	imageUUID, err := uuid.Parse(req.GetId())
 	if err != nil {
-		s.logger.Error("failed to get images", "error", err)
-		return nil, status.Error(codes.Internal, "failed to retrieve images")
+		return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
 	}
 
-	// 3. Konvertatsiya
-	return &pb.Image{
-		Id:         dbImage.ID,
-		CarId:      dbImage.CarID,
-		Filename:   dbImage.Filename,
-		UploadedAt: dbImage.UploadedAt.Time.Format(time.RFC3339),
-	}, nil
-}
+	// 3. Tiklash. Tiklangan rasm yana e'lon rasmlari qatoriga qo'shiladi,
+	// shuning uchun egasining rejasi yangi rasm kabi tekshiriladi
+	imageID := pgtype.UUID{Bytes: imageUUID, Valid: true}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		deleted, err := q.GetDeletedImageCar(ctx, imageID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errNotInTrash
+		}
+		if err != nil {
+			return fmt.Errorf("get deleted image: %w", err)
+		}
+		carUUID, err := uuid.Parse(deleted.CarID)
+		if err != nil {
+			return fmt.Errorf("parse car id: %w", err)
+		}
+		ownerUUID, err := uuid.Parse(deleted.OwnerID)
+		if err != nil {
+			return fmt.Errorf("parse owner id: %w", err)
+		}
+		carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+		if err := enforceImageQuota(ctx, q, carID, pgtype.UUID{Bytes: ownerUUID, Valid: true}); err != nil {
+			return err
+		}
+
+		restored, err := q.RestoreImage(ctx, imageID)
+		if err != nil {
+			return err
+		}
+		if restored == 0 {
+			return errNotInTrash
+		}
+		// Mashinada muqova qolmagan bo'lsa, tiklangan rasm muqova bo'lishi mumkin
+		if err := q.EnsureCoverImage(ctx, carID); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "RestoreImage", entityImage, req.GetId(), nil, nil)
+	})
+	switch {
+	case isQuotaError(err):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, errNotInTrash):
+		return nil, status.Error(codes.NotFound, "deleted image not found")
+	case err != nil:
+		s.logger.Error("failed to restore image", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore image")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// GetImagesByCar - Avtomobil rasmlarini olish
+func (s *CarService) GetImageByID(ctx context.Context, req *pb.ImageId) (*pb.Image, error) {
+	// 1. UUID konvertatsiyasi
+	imageID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
+	}
+
+	// 2. Ma'lumotlarni olish
+	dbImage, err := s.store.GetImageById(ctx, pgtype.UUID{Bytes: imageID, Valid: true})
+	if err != nil {
+		s.logger.Error("failed to get images", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve images")
+	}
+
+	// 3. Konvertatsiya
+	return s.convertDBImageToProto(dbImage), nil
+}
+
+// CreateImageUploadURL - Saqlash qatlamiga to'g'ridan-to'g'ri yuklash uchun imzolangan URL.
+// Fayl tasdiqlash bosqichida tekshiriladi va shundan keyingina images yozuvi yaratiladi
+func (s *CarService) CreateImageUploadURL(ctx context.Context, req *pb.CreateImageUploadURLRequest) (*pb.SignedURL, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+			return nil, err
+		}
+	}
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	if s.signer == nil {
+		return nil, status.Error(codes.Unavailable, "direct uploads are not configured")
+	}
+
+	// 2. Kvota oldindan tekshiriladi, yuklangan fayl tasdiqlashda yana tekshiriladi
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+	dbCar, err := s.store.GetCarById(ctx, carID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "car not found")
+	}
+	ownerUUID, err := uuid.Parse(dbCar.OwnerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid owner ID")
+	}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		return enforceImageQuota(ctx, q, carID, pgtype.UUID{Bytes: ownerUUID, Valid: true})
+	})
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to check image quota", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create upload URL")
+	}
+
+	// 3. Imzolash
+	return s.signedURL(http.MethodPut, blob.IncomingKey(carUUID.String()))
+}
+
+// CreateImageDownloadURL - Asl rasm faylini yuklab olish uchun imzolangan URL
+func (s *CarService) CreateImageDownloadURL(ctx context.Context, req *pb.ImageId) (*pb.SignedURL, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkImageOwnership(ctx, req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+	imageUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
+	}
+	if s.signer == nil {
+		return nil, status.Error(codes.Unavailable, "signed downloads are not configured")
+	}
+
+	// 2. Rasmni olish
+	dbImage, err := s.store.GetImageById(ctx, pgtype.UUID{Bytes: imageUUID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "image not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get image", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve image")
+	}
+	if strings.Contains(dbImage.Filename, "://") {
+		return nil, status.Error(codes.FailedPrecondition, "image is not kept in blob storage")
+	}
+
+	// 3. Imzolash
+	return s.signedURL(http.MethodGet, dbImage.Filename)
+}
+
+// ReorderCarImages - Rasmlar tartibini va muqova rasmini o'zgartirish
+func (s *CarService) ReorderCarImages(ctx context.Context, req *pb.ReorderCarImagesRequest) (*pb.ListImagesResponse, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+			return nil, err
+		}
+	}
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+
+	// 2. Validatsiya: ro'yxat mashinaning barcha rasmlarini aynan bir martadan o'z ichiga olishi kerak
+	imageIDs := make([]pgtype.UUID, len(req.GetImageIds()))
+	seen := make(map[string]bool, len(req.GetImageIds()))
+	for i, id := range req.GetImageIds() {
+		imageUUID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid image ID format")
+		}
+		if seen[imageUUID.String()] {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("image %s listed more than once", id))
+		}
+		seen[imageUUID.String()] = true
+		imageIDs[i] = pgtype.UUID{Bytes: imageUUID, Valid: true}
+	}
+	var coverID pgtype.UUID
+	if req.GetCoverImageId() != "" {
+		coverUUID, err := uuid.Parse(req.GetCoverImageId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cover image ID format")
+		}
+		if !seen[coverUUID.String()] {
+			return nil, status.Error(codes.InvalidArgument, "cover image must be one of the car images")
+		}
+		coverID = pgtype.UUID{Bytes: coverUUID, Valid: true}
+	}
+
+	before, err := s.GetImagesByCar(ctx, &pb.CarId{CarId: req.GetCarId()})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Tranzaksiya ichida tartib va muqovani yangilash
+	var after *pb.ListImagesResponse
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		current, err := q.GetImagesByCar(ctx, carID)
+		if err != nil {
+			return err
+		}
+		if len(current) != len(imageIDs) {
+			return errImageSetMismatch
+		}
+		for _, img := range current {
+			if !seen[img.ID] {
+				return errImageSetMismatch
+			}
+		}
+
+		if err := q.SetImagePositions(ctx, sqlc.SetImagePositionsParams{ImageIds: imageIDs, CarID: carID}); err != nil {
+			return err
+		}
+		if coverID.Valid {
+			// Bitta muqova indeksi tufayli avval eskisi olib tashlanadi
+			if err := q.ClearCoverImage(ctx, carID); err != nil {
+				return err
+			}
+			if _, err := q.SetCoverImage(ctx, sqlc.SetCoverImageParams{ID: coverID, CarID: carID}); err != nil {
+				return err
+			}
+		}
+
+		dbImages, err := q.GetImagesByCar(ctx, carID)
+		if err != nil {
+			return err
+		}
+		images := make([]*pb.Image, len(dbImages))
+		for i, dbImg := range dbImages {
+			images[i] = s.convertDBImageToProto(dbImg)
+		}
+		after = &pb.ListImagesResponse{Images: images}
+		return s.audit(ctx, q, "ReorderCarImages", entityCar, req.GetCarId(), before, after)
+	})
+	if errors.Is(err, errImageSetMismatch) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to reorder images", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reorder images")
+	}
+
+	return after, nil
+}
+
+// ---------------------- MEDIA ----------------------
+
+// AddCarMedia - Video yoki hujjat yozuvini yaratish (fayl upload handler tomonidan saqlangan)
+func (s *CarService) AddCarMedia(ctx context.Context, req *pb.AddCarMediaRequest) (*pb.MediaItem, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Validatsiya. Rasmlar AddImage orqali qo'shiladi (tekshiruv, o'lchamlar va muqova)
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	if req.GetKind() != mediaVideo && req.GetKind() != mediaDocument {
+		return nil, status.Error(codes.InvalidArgument, "kind must be video or document")
+	}
+	if req.GetVisibility() != visibilityPublic && req.GetVisibility() != visibilityPrivate {
+		return nil, status.Error(codes.InvalidArgument, "visibility must be public or private")
+	}
+	if !strings.HasPrefix(req.GetFilename(), mediaKeyPrefix(carUUID.String(), req.GetVisibility())) {
+		return nil, status.Error(codes.InvalidArgument, "filename was not stored for this car and visibility")
+	}
+	if req.GetMimeType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mime_type is required")
+	}
+	if req.GetSizeBytes() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "size_bytes must not be negative")
+	}
+
+	arg := sqlc.AddMediaParams{
+		CarID:      pgtype.UUID{Bytes: carUUID, Valid: true},
+		Filename:   req.GetFilename(),
+		Kind:       req.GetKind(),
+		MimeType:   zero.StringFrom(req.GetMimeType()),
+		Visibility: req.GetVisibility(),
+		SizeBytes:  pgtype.Int8{Int64: req.GetSizeBytes(), Valid: req.GetSizeBytes() > 0},
+		Checksum:   zero.StringFrom(req.GetChecksum()),
+	}
+
+	// 3. Chegara tekshiruvi va yozuv bitta tranzaksiyada
+	var dbMedia sqlc.AddMediaRow
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := enforceMediaQuota(ctx, q, arg.CarID); err != nil {
+			return err
+		}
+		var err error
+		if dbMedia, err = q.AddMedia(ctx, arg); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "AddCarMedia", entityMedia, dbMedia.ID, nil, mediaSnapshot(sqlc.ListCarMediaRow(dbMedia)))
+	})
+	if isQuotaError(err) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		s.logger.Error("failed to add media", "error", err)
+		return nil, status.Error(codes.Internal, "failed to add media")
+	}
+
+	// 4. Konvertatsiya
+	return s.convertMediaToProto(sqlc.ListCarMediaRow(dbMedia))
+}
+
+// AuthorizeCarUpload - Fayl yuklanishidan oldin e'lonni tahrirlash huquqini tekshirish
+// (upload handler tanani o'qimasdan va saqlamasdan oldin chaqiradi)
+func (s *CarService) AuthorizeCarUpload(ctx context.Context, req *pb.CarId) (*pb.Empty, error) {
+	if s.isAdmin(ctx) {
+		return &pb.Empty{}, nil
+	}
+	if err := s.checkCarOwnership(ctx, req.GetCarId()); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+// ListCarMedia - Avtomobil rasmlari, videolari va hujjatlari. O'chirilgan yoki tasdiqlanmagan
+// e'lon GetCarById dagi kabi boshqalar uchun mavjud emasdek ko'rinadi. Yopiq elementlar faqat
+// e'lon egasi, tahrirlash huquqi bor tashkilot a'zolari va adminlarga ko'rinadi
+func (s *CarService) ListCarMedia(ctx context.Context, req *pb.ListCarMediaRequest) (*pb.ListCarMediaResponse, error) {
+	// 1. Validatsiya
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	switch req.GetKind() {
+	case "", mediaImage, mediaVideo, mediaDocument:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "kind must be image, video or document")
+	}
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+
+	// 2. Ruxsat: tahrirlash huquqi borlar yopiq elementlarni ham ko'radi, qolganlar uchun
+	// e'lon ko'rinadigan bo'lishi shart
+	hasAccess := s.isAdmin(ctx) || s.checkCarOwnership(ctx, req.GetCarId()) == nil
+	if !hasAccess {
+		dbCar, err := s.store.GetCarById(ctx, carID)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, "car not found")
+		}
+		if dbCar.ModerationStatus != moderationApproved && !canViewUnmoderated(ctx, dbCar.OwnerID) {
+			return nil, status.Error(codes.NotFound, "car not found")
+		}
+	}
+
+	// 3. Ma'lumotlarni olish
+	dbMedia, err := s.store.ListCarMedia(ctx, sqlc.ListCarMediaParams{
+		CarID:          carID,
+		Kind:           zero.StringFrom(req.GetKind()),
+		IncludePrivate: hasAccess,
+	})
+	if err != nil {
+		s.logger.Error("failed to list media", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve media")
+	}
+
+	// 4. Konvertatsiya
+	items := make([]*pb.MediaItem, len(dbMedia))
+	for i, item := range dbMedia {
+		if items[i], err = s.convertMediaToProto(item); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListCarMediaResponse{Items: items}, nil
+}
+
+// DeleteCarMedia - Media elementini trashga o'tkazish
+func (s *CarService) DeleteCarMedia(ctx context.Context, req *pb.MediaId) (*pb.Empty, error) {
+	// 1. Elementni olish
+	mediaUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid media ID format")
+	}
+	mediaID := pgtype.UUID{Bytes: mediaUUID, Valid: true}
+	dbMedia, err := s.store.GetMediaById(ctx, mediaID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "media not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get media", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve media")
+	}
+
+	// 2. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCarOwnership(ctx, dbMedia.CarID); err != nil {
+			return nil, err
+		}
+	}
+	carUUID, err := uuid.Parse(dbMedia.CarID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid car ID")
+	}
+
+	// 3. O'chirish. Muqova rasm o'chirilsa, keyingi rasm muqova bo'ladi
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteImage(ctx, mediaID); err != nil {
+			return err
+		}
+		if dbMedia.Kind == mediaImage {
+			if err := q.EnsureCoverImage(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+				return err
+			}
+		}
+		return s.audit(ctx, q, "DeleteCarMedia", entityMedia, req.GetId(), mediaSnapshot(sqlc.ListCarMediaRow(dbMedia)), nil)
+	})
+	if err != nil {
+		s.logger.Error("failed to delete media", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete media")
+	}
+
+	return &pb.Empty{}, nil
+}
 
 // ---------------------- COMMENTS ----------------------
 
@@ -930,60 +1806,175 @@ func (s *CarService) CreateComment(ctx context.Context, req *pb.CreateCommentReq
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
-	// 3. SQL parametrlari
+	// 3. Javob: ota kommentariya shu e'longa tegishli, e'lon qilingan va chuqurlik chegarasida bo'lishi kerak
+	var parentID pgtype.UUID
+	if req.GetParentId() != "" {
+		parentUUID, err := uuid.Parse(req.GetParentId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid parent comment ID format")
+		}
+		parentID = pgtype.UUID{Bytes: parentUUID, Valid: true}
+		parent, err := s.store.GetCommentThreadInfo(ctx, parentID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "parent comment not found")
+		}
+		if err != nil {
+			s.logger.Error("failed to get parent comment", "error", err)
+			return nil, status.Error(codes.Internal, "failed to retrieve parent comment")
+		}
+		if parent.CarID != carUUID.String() {
+			return nil, status.Error(codes.InvalidArgument, "parent comment belongs to another car")
+		}
+		if parent.ModerationStatus != moderationApproved {
+			return nil, status.Error(codes.FailedPrecondition, "parent comment is not published")
+		}
+		if int(parent.Depth) >= s.commentMaxDepth {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("replies are limited to %d levels", s.commentMaxDepth))
+		}
+	}
+
+	// 4. SQL parametrlari
 	arg := sqlc.CreateCommentParams{
-		UserID:  pgtype.UUID{Bytes: userUUID, Valid: true},
-		CarID:   pgtype.UUID{Bytes: carUUID, Valid: true},
-		Content: zero.StringFrom(req.GetContent()),
+		UserID:   pgtype.UUID{Bytes: userUUID, Valid: true},
+		CarID:    pgtype.UUID{Bytes: carUUID, Valid: true},
+		Content:  zero.StringFrom(req.GetContent()),
+		ParentID: parentID,
 	}
 
-	// 4. Bazaga yozish
-	dbComment, err := s.store.CreateComment(ctx, arg)
+	// 5. Bazaga yozish, moderatsiya navbatiga qo'yish va audit
+	var comment *pb.Comment
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbComment, err := q.CreateComment(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("create comment: %w", err)
+		}
+		commentUUID, err := uuid.Parse(dbComment.ID)
+		if err != nil {
+			return fmt.Errorf("parse comment id: %w", err)
+		}
+		if err := s.enqueueModeration(ctx, q, entityComment, pgtype.UUID{Bytes: commentUUID, Valid: true}, arg.UserID); err != nil {
+			return err
+		}
+
+		comment = &pb.Comment{
+			Id:               dbComment.ID,
+			UserId:           dbComment.UserID,
+			CarId:            dbComment.CarID,
+			Content:          dbComment.Content,
+			CreatedAt:        dbComment.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:        dbComment.UpdatedAt.Time.Format(time.RFC3339),
+			ModerationStatus: s.initialModerationStatus(),
+			Depth:            dbComment.Depth,
+			IsSeller:         dbComment.IsSeller,
+		}
+		if dbComment.ParentID.Valid {
+			comment.ParentId = uuid.UUID(dbComment.ParentID.Bytes).String()
+		}
+		return s.audit(ctx, q, "CreateComment", entityComment, comment.Id, nil, comment)
+	})
 	if err != nil {
 		s.logger.Error("failed to create comment", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create comment")
 	}
 
-	// 5. Protobuf response
-	return &pb.Comment{
-		Id:        dbComment.ID,
-		UserId:    dbComment.UserID,
-		CarId:     dbComment.CarID,
-		Content:   dbComment.Content,
-		CreatedAt: dbComment.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt: dbComment.UpdatedAt.Time.Format(time.RFC3339),
-	}, nil
+	// 6. Protobuf response
+	return comment, nil
 }
 
-// GetCommentsByCar - Avtomobil kommentariyalari
-func (s *CarService) GetCommentsByCar(ctx context.Context, req *pb.CarId) (*pb.ListCommentsResponse, error) {
-	// 1. UUID konvertatsiyasi
+// GetCommentsByCar - Avtomobil kommentariyalari: ildiz kommentariyalar sahifasi va ularning javoblari
+func (s *CarService) GetCommentsByCar(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	// 1. Validatsiya
 	carUUID, err := uuid.Parse(req.GetCarId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
 
-	// 2. Ma'lumotlarni olish
-	dbComments, err := s.store.GetCommentsByCar(ctx, pgtype.UUID{Bytes: carUUID, Valid: true})
+	sortBy := req.GetSort()
+	if sortBy == "" {
+		sortBy = commentSortNewest
+	}
+	if sortBy != commentSortNewest && sortBy != commentSortOldest && sortBy != commentSortMostLiked {
+		return nil, status.Error(codes.InvalidArgument, "sort must be newest, oldest or most_liked")
+	}
+
+	limit := req.GetLimit()
+	if limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultCommentPageSize
+	}
+	if limit > maxCommentPageSize {
+		limit = maxCommentPageSize
+	}
+
+	var cursor *commentCursor
+	if req.GetCursor() != "" {
+		c, err := decodeCommentCursor(req.GetCursor(), sortBy)
+		if err != nil {
+			return nil, err
+		}
+		cursor = &c
+	}
+
+	// 2. Ildiz kommentariyalar sahifasi (keyingi sahifa borligini bilish uchun bittaga ko'p)
+	roots, err := s.rootComments(ctx, carID, sortBy, cursor, limit+1)
 	if err != nil {
 		s.logger.Error("failed to get comments", "error", err)
 		return nil, status.Error(codes.Internal, "failed to retrieve comments")
 	}
+	var nextCursor string
+	if len(roots) > int(limit) {
+		roots = roots[:limit]
+		last := roots[len(roots)-1]
+		lastUUID, _ := uuid.Parse(last.ID)
+		nextCursor = encodeCommentCursor(commentCursor{
+			Sort:      sortBy,
+			ID:        lastUUID,
+			CreatedAt: last.CreatedAt.Time,
+			Likes:     last.LikeCount,
+		})
+	}
 
-	// 3. Konvertatsiya
-	comments := make([]*pb.Comment, len(dbComments))
-	for i, dbComment := range dbComments {
-		comments[i] = &pb.Comment{
-			Id:        dbComment.ID,
-			UserId:    dbComment.UserID,
-			CarId:     dbComment.CarID,
-			Content:   dbComment.Content,
-			CreatedAt: dbComment.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt: dbComment.UpdatedAt.Time.Format(time.RFC3339),
+	// 3. Sahifadagi ildizlarning javoblari
+	rows := roots
+	if len(roots) > 0 {
+		rootIDs := make([]pgtype.UUID, len(roots))
+		for i, r := range roots {
+			rootUUID, _ := uuid.Parse(r.ID)
+			rootIDs[i] = pgtype.UUID{Bytes: rootUUID, Valid: true}
+		}
+		// Javob otasidan keyin yoziladi, shuning uchun eng eski javoblar chegarasi shoxni uzmaydi
+		replies, err := s.store.GetCommentReplies(ctx, sqlc.GetCommentRepliesParams{
+			RootIds:    rootIDs,
+			ReplyLimit: pgtype.Int4{Int32: maxRepliesPerComment, Valid: true},
+		})
+		if err != nil {
+			s.logger.Error("failed to get comment replies", "error", err)
+			return nil, status.Error(codes.Internal, "failed to retrieve comments")
 		}
+		rows = append(rows, replies...)
+	}
+
+	// 4. Umumiy son
+	total, err := s.store.CountCarComments(ctx, carID)
+	if err != nil {
+		s.logger.Error("failed to count comments", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve comments")
 	}
 
-	return &pb.ListCommentsResponse{Comments: comments}, nil
+	// 5. Konvertatsiya: har bir javob otasidan keyin keladi
+	comments := threadComments(rows)
+	if err := s.attachReactions(ctx, comments, actorIDFromContext(ctx)); err != nil {
+		s.logger.Error("failed to get comment reactions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve comments")
+	}
+	return &pb.ListCommentsResponse{
+		Comments:   comments,
+		NextCursor: nextCursor,
+		TotalCount: total,
+	}, nil
 }
 
 // UpdateComment - Kommentariyani yangilash
@@ -1004,8 +1995,19 @@ func (s *CarService) UpdateComment(ctx context.Context, req *pb.UpdateCommentReq
 		Content: zero.StringFrom(req.GetContent()),
 		ID:      pgtype.UUID{Bytes: commentUUID, Valid: true},
 	}
+	before := s.commentSnapshot(ctx, s.store, arg.ID)
 
-	if err := s.store.UpdateComment(ctx, arg); err != nil {
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.UpdateComment(ctx, arg); err != nil {
+			return err
+		}
+		// Rad etilgan, o'zgartirish so'ralgan yoki (pre-moderatsiyada) tasdiqlangan kommentariya qayta moderatsiyaga yuboriladi
+		if err := s.resubmitForModeration(ctx, q, entityComment, arg.ID, actorIDFromContext(ctx)); err != nil {
+			return fmt.Errorf("resubmit for moderation: %w", err)
+		}
+		return s.audit(ctx, q, "UpdateComment", entityComment, req.GetId(), before, s.commentSnapshot(ctx, q, arg.ID))
+	})
+	if err != nil {
 		s.logger.Error("failed to update comment", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update comment")
 	}
@@ -1026,8 +2028,16 @@ func (s *CarService) DeleteComment(ctx context.Context, req *pb.CommentId) (*pb.
 		return nil, status.Error(codes.InvalidArgument, "invalid comment ID format")
 	}
 
-	// 3. O'chirish
-	if err := s.store.DeleteComment(ctx, pgtype.UUID{Bytes: commentUUID, Valid: true}); err != nil {
+	// 3. O'chirish va audit
+	id := pgtype.UUID{Bytes: commentUUID, Valid: true}
+	before := s.commentSnapshot(ctx, s.store, id)
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteComment(ctx, id); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteComment", entityComment, req.GetId(), before, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete comment", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete comment")
 	}
@@ -1048,8 +2058,14 @@ func (s *CarService) DeleteCommentsByCarId(ctx context.Context, req *pb.CarId) (
 		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
 	}
 
-	// 3. O'chirish
-	if err := s.store.DeleteCommentsByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+	// 3. O'chirish va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.DeleteCommentsByCarId(ctx, pgtype.UUID{Bytes: carUUID, Valid: true}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteCommentsByCarId", entityCar, req.GetCarId(), nil, nil)
+	})
+	if err != nil {
 		s.logger.Error("failed to delete comments", "error", err)
 		return nil, status.Error(codes.Internal, "failed to delete comments")
 	}
@@ -1057,19 +2073,145 @@ func (s *CarService) DeleteCommentsByCarId(ctx context.Context, req *pb.CarId) (
 	return &pb.Empty{}, nil
 }
 
-// CheckCommentOwnership - Kommentariya tegishligini tekshirish
-func (s *CarService) CheckCommentOwnership(ctx context.Context, req *pb.BoolCheckComment) (*pb.BoolCheck, error) {
-	// 1. UUID konvertatsiyalari
-	userUUID, err := uuid.Parse(req.GetUserId())
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+// RestoreComment - O'chirilgan kommentariyani tiklash
+func (s *CarService) RestoreComment(ctx context.Context, req *pb.CommentId) (*pb.Empty, error) {
+	// 1. Tegishlik tekshiruvi (admin uchun shart emas)
+	if !s.isAdmin(ctx) {
+		if err := s.checkCommentOwnership(ctx, req.GetId()); err != nil {
+			return nil, err
+		}
 	}
-	commentUUID, err := uuid.Parse(req.GetCommentId())
+
+	// 2. UUID konvertatsiyasi
+	commentUUID, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid comment ID format")
 	}
 
-	// 2. Tekshiruv
+	// 3. Tiklash va audit
+	id := pgtype.UUID{Bytes: commentUUID, Valid: true}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		restored, err := q.RestoreComment(ctx, id)
+		if err != nil {
+			return err
+		}
+		if restored == 0 {
+			return errNotInTrash
+		}
+		return s.audit(ctx, q, "RestoreComment", entityComment, req.GetId(), nil, s.commentSnapshot(ctx, q, id))
+	})
+	if errors.Is(err, errNotInTrash) {
+		return nil, status.Error(codes.NotFound, "deleted comment not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to restore comment", "error", err)
+		return nil, status.Error(codes.Internal, "failed to restore comment")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ReactToComment - Kommentariyaga reaksiya qo'yish yoki uni almashtirish
+func (s *CarService) ReactToComment(ctx context.Context, req *pb.ReactToCommentRequest) (*pb.CommentReactions, error) {
+	// 1. Validatsiya
+	if !commentReactionTypes[req.GetReaction()] {
+		return nil, status.Error(codes.InvalidArgument, "reaction must be like or helpful")
+	}
+	commentID, userID, err := s.reactionTarget(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Reaksiyani saqlash, layklar sonini qayta hisoblash va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := lockCommentReactions(ctx, q, commentID); err != nil {
+			return err
+		}
+		mine, err := q.GetUserCommentReactions(ctx, sqlc.GetUserCommentReactionsParams{
+			UserID:     userID,
+			CommentIds: []pgtype.UUID{commentID},
+		})
+		if err != nil {
+			return err
+		}
+		var before string
+		if len(mine) > 0 {
+			before = mine[0].Reaction
+		}
+		if err := q.UpsertCommentReaction(ctx, sqlc.UpsertCommentReactionParams{
+			CommentID: commentID,
+			UserID:    userID,
+			Reaction:  req.GetReaction(),
+		}); err != nil {
+			return err
+		}
+		if err := q.RefreshCommentLikeCount(ctx, commentID); err != nil {
+			return err
+		}
+		if before == req.GetReaction() {
+			return nil
+		}
+		return s.audit(ctx, q, "ReactToComment", entityComment, req.GetId(),
+			map[string]interface{}{"reaction": before},
+			map[string]interface{}{"reaction": req.GetReaction()},
+		)
+	})
+	if err != nil {
+		s.logger.Error("failed to react to comment", "error", err)
+		return nil, status.Error(codes.Internal, "failed to react to comment")
+	}
+
+	// 3. Yangilangan hisoblagichlar
+	return s.commentReactions(ctx, commentID, req.GetReaction())
+}
+
+// RemoveCommentReaction - Kommentariyadagi o'z reaksiyasini olib tashlash
+func (s *CarService) RemoveCommentReaction(ctx context.Context, req *pb.CommentId) (*pb.CommentReactions, error) {
+	// 1. Validatsiya
+	commentID, userID, err := s.reactionTarget(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. O'chirish, layklar sonini qayta hisoblash va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := lockCommentReactions(ctx, q, commentID); err != nil {
+			return err
+		}
+		removed, err := q.DeleteCommentReaction(ctx, sqlc.DeleteCommentReactionParams{
+			CommentID: commentID,
+			UserID:    userID,
+		})
+		if err != nil || removed == 0 {
+			return err
+		}
+		if err := q.RefreshCommentLikeCount(ctx, commentID); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "RemoveCommentReaction", entityComment, req.GetId(), nil, nil)
+	})
+	if err != nil {
+		s.logger.Error("failed to remove comment reaction", "error", err)
+		return nil, status.Error(codes.Internal, "failed to remove reaction")
+	}
+
+	// 3. Yangilangan hisoblagichlar
+	return s.commentReactions(ctx, commentID, "")
+}
+
+// CheckCommentOwnership - Kommentariya tegishligini tekshirish
+func (s *CarService) CheckCommentOwnership(ctx context.Context, req *pb.BoolCheckComment) (*pb.BoolCheck, error) {
+	// 1. UUID konvertatsiyalari
+	userUUID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	commentUUID, err := uuid.Parse(req.GetCommentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid comment ID format")
+	}
+
+	// 2. Tekshiruv
 	isOwner, err := s.store.CheckCommentOwnership(ctx, sqlc.CheckCommentOwnershipParams{
 		ID:     pgtype.UUID{Bytes: commentUUID, Valid: true},
 		UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
@@ -1081,3 +2223,1816 @@ func (s *CarService) CheckCommentOwnership(ctx context.Context, req *pb.BoolChec
 
 	return &pb.BoolCheck{Result: isOwner}, nil
 }
+
+// ---------------------- REVIEWS ----------------------
+
+// CreateReview - E'lon yoki sotuvchiga baho qo'yish
+func (s *CarService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.Review, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Validatsiya
+	content, err := validateReview(req.GetRating(), req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+	targetID, sellerID, err := s.reviewTarget(ctx, req.GetTargetType(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	if sellerID == userUUID {
+		return nil, status.Error(codes.InvalidArgument, "you cannot review yourself or your own listing")
+	}
+
+	// 3. Faqat sotuvchi bilan yozishgan foydalanuvchi baho qo'ya oladi
+	exchanged, err := s.store.HasExchangedMessages(ctx, sqlc.HasExchangedMessagesParams{
+		UserID:   pgtype.UUID{Bytes: userUUID, Valid: true},
+		SellerID: pgtype.UUID{Bytes: sellerID, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to check message history", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create review")
+	}
+	if !exchanged {
+		return nil, status.Error(codes.FailedPrecondition, "you can review only sellers you have exchanged messages with")
+	}
+
+	// 4. Yozish, moderatsiya navbati va agregatni qayta hisoblash bitta tranzaksiyada
+	// (pre-moderatsiyada baho tasdiqlanguncha reytingga kirmaydi)
+	arg := sqlc.CreateReviewParams{
+		ReviewerID: pgtype.UUID{Bytes: userUUID, Valid: true},
+		TargetType: req.GetTargetType(),
+		TargetID:   pgtype.UUID{Bytes: targetID, Valid: true},
+		SellerID:   pgtype.UUID{Bytes: sellerID, Valid: true},
+		Rating:     int16(req.GetRating()),
+		Content:    content,
+	}
+	var review *pb.Review
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := lockReviewTarget(ctx, q, arg.TargetType, arg.TargetID); err != nil {
+			return err
+		}
+		dbReview, err := q.CreateReview(ctx, arg)
+		if err != nil {
+			return err
+		}
+		reviewUUID, err := uuid.Parse(dbReview.ID)
+		if err != nil {
+			return fmt.Errorf("invalid review ID: %w", err)
+		}
+		if err := s.enqueueModeration(ctx, q, entityReview, pgtype.UUID{Bytes: reviewUUID, Valid: true}, arg.ReviewerID); err != nil {
+			return err
+		}
+		review = convertReviewToProto(sqlc.GetReviewByIdRow(dbReview))
+		return s.audit(ctx, q, "CreateReview", entityReview, review.Id, nil, review)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.AlreadyExists, "you have already reviewed this "+req.GetTargetType())
+	}
+	if err != nil {
+		s.logger.Error("failed to create review", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create review")
+	}
+
+	return review, nil
+}
+
+// UpdateReview - Bahoni o'zgartirish (faqat muallif)
+func (s *CarService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.Review, error) {
+	// 1. Validatsiya
+	content, err := validateReview(req.GetRating(), req.GetContent())
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Tegishlik tekshiruvi
+	before, err := s.getOwnReview(ctx, req.GetId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Yangilash, agregatni qayta hisoblash va audit
+	reviewUUID, _ := uuid.Parse(before.ID)
+	targetUUID, _ := uuid.Parse(before.TargetID)
+	targetID := pgtype.UUID{Bytes: targetUUID, Valid: true}
+	var review *pb.Review
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := lockReviewTarget(ctx, q, before.TargetType, targetID); err != nil {
+			return err
+		}
+		dbReview, err := q.UpdateReview(ctx, sqlc.UpdateReviewParams{
+			Rating:  int16(req.GetRating()),
+			Content: content,
+			ID:      pgtype.UUID{Bytes: reviewUUID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		// Rad etilgan, o'zgartirish so'ralgan yoki (pre-moderatsiyada) tasdiqlangan baho qayta moderatsiyaga yuboriladi
+		if err := s.resubmitForModeration(ctx, q, entityReview, pgtype.UUID{Bytes: reviewUUID, Valid: true}, actorIDFromContext(ctx)); err != nil {
+			return fmt.Errorf("resubmit for moderation: %w", err)
+		}
+		if err := refreshRating(ctx, q, before.TargetType, targetID); err != nil {
+			return err
+		}
+		review = convertReviewToProto(sqlc.GetReviewByIdRow(dbReview))
+		return s.audit(ctx, q, "UpdateReview", entityReview, review.Id, convertReviewToProto(before), review)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to update review", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update review")
+	}
+
+	return review, nil
+}
+
+// DeleteReview - Bahoni o'chirish (muallif yoki admin)
+func (s *CarService) DeleteReview(ctx context.Context, req *pb.ReviewId) (*pb.Empty, error) {
+	// 1. Tegishlik tekshiruvi
+	before, err := s.getOwnReview(ctx, req.GetId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. O'chirish, agregatni qayta hisoblash va audit
+	reviewUUID, _ := uuid.Parse(before.ID)
+	targetUUID, _ := uuid.Parse(before.TargetID)
+	targetID := pgtype.UUID{Bytes: targetUUID, Valid: true}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := lockReviewTarget(ctx, q, before.TargetType, targetID); err != nil {
+			return err
+		}
+		if err := q.DeleteReview(ctx, pgtype.UUID{Bytes: reviewUUID, Valid: true}); err != nil {
+			return err
+		}
+		if err := refreshRating(ctx, q, before.TargetType, targetID); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "DeleteReview", entityReview, before.ID, convertReviewToProto(before), nil)
+	})
+	if err != nil {
+		s.logger.Error("failed to delete review", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete review")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ListReviews - E'lon yoki sotuvchining tasdiqlangan baholari, yangilari birinchi.
+// O'chirilgan yoki tasdiqlanmagan e'lon baholari GetCarById dagi kabi yashiriladi
+func (s *CarService) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	// 1. Validatsiya
+	if req.GetTargetType() != reviewTargetCar && req.GetTargetType() != reviewTargetSeller {
+		return nil, status.Error(codes.InvalidArgument, "target_type must be car or seller")
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	targetUUID, err := uuid.Parse(req.GetTargetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target ID format")
+	}
+	targetID := pgtype.UUID{Bytes: targetUUID, Valid: true}
+	if req.GetTargetType() == reviewTargetCar {
+		if _, _, err := s.reviewTarget(ctx, reviewTargetCar, req.GetTargetId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Baholarni olish
+	dbReviews, err := s.store.ListReviews(ctx, sqlc.ListReviewsParams{
+		TargetType: req.GetTargetType(),
+		TargetID:   targetID,
+		Offset:     pgtype.Int4{Int32: req.GetOffset(), Valid: true},
+		Limit:      pgtype.Int4{Int32: clampLimit(req.GetLimit(), defaultReviewPageSize, maxReviewPageSize), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to list reviews", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reviews")
+	}
+
+	// 3. Agregat
+	var sum, count int32
+	if req.GetTargetType() == reviewTargetCar {
+		var r sqlc.GetCarRatingRow
+		r, err = s.store.GetCarRating(ctx, targetID)
+		sum, count = r.RatingSum, r.RatingCount
+	} else {
+		var r sqlc.GetSellerRatingRow
+		r, err = s.store.GetSellerRating(ctx, targetID)
+		sum, count = r.RatingSum, r.RatingCount
+	}
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.logger.Error("failed to get rating", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reviews")
+	}
+
+	// 4. Konvertatsiya
+	reviews := make([]*pb.Review, len(dbReviews))
+	for i, r := range dbReviews {
+		reviews[i] = convertReviewToProto(sqlc.GetReviewByIdRow(r))
+	}
+
+	return &pb.ListReviewsResponse{
+		Reviews:     reviews,
+		RatingAvg:   ratingAverage(sum, count),
+		RatingCount: count,
+	}, nil
+}
+
+// GetSellerSummary - Sotuvchi reytingi va faol e'lonlari soni
+func (s *CarService) GetSellerSummary(ctx context.Context, req *pb.SellerSummaryRequest) (*pb.SellerSummary, error) {
+	// 1. Validatsiya
+	sellerUUID, err := uuid.Parse(req.GetSellerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid seller ID format")
+	}
+	sellerID := pgtype.UUID{Bytes: sellerUUID, Valid: true}
+
+	// 2. Reyting (baho bo'lmasa nol)
+	rating, err := s.store.GetSellerRating(ctx, sellerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		s.logger.Error("failed to get seller rating", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get seller summary")
+	}
+
+	// 3. Faol e'lonlar
+	active, err := s.store.CountActiveListings(ctx, sqlc.CountActiveListingsParams{OwnerID: sellerID})
+	if err != nil {
+		s.logger.Error("failed to count active listings", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get seller summary")
+	}
+
+	return &pb.SellerSummary{
+		SellerId:       req.GetSellerId(),
+		RatingAvg:      ratingAverage(rating.RatingSum, rating.RatingCount),
+		RatingCount:    rating.RatingCount,
+		ActiveListings: active,
+	}, nil
+}
+
+// ---------------------- AUDIT ----------------------
+
+// GetAuditTrail - Obyekt o'zgarishlari tarixi (faqat admin va obyekt egasi uchun)
+func (s *CarService) GetAuditTrail(ctx context.Context, req *pb.GetAuditTrailRequest) (*pb.ListAuditEntriesResponse, error) {
+	// 1. UUID konvertatsiyasi
+	entityUUID, err := uuid.Parse(req.GetEntityId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid entity ID format")
+	}
+
+	// 2. Ruxsat tekshiruvi
+	if !s.isAdmin(ctx) {
+		if err := s.checkEntityOwnership(ctx, req.GetEntityType(), req.GetEntityId()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Ma'lumotlarni olish
+	dbEntries, err := s.store.GetAuditTrail(ctx, sqlc.GetAuditTrailParams{
+		EntityType: req.GetEntityType(),
+		EntityID:   pgtype.UUID{Bytes: entityUUID, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to get audit trail", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve audit trail")
+	}
+
+	// 4. Konvertatsiya
+	entries := make([]*pb.AuditEntry, len(dbEntries))
+	for i, e := range dbEntries {
+		entries[i] = &pb.AuditEntry{
+			Id:         e.ID,
+			Rpc:        e.Rpc,
+			EntityType: e.EntityType,
+			EntityId:   e.EntityID,
+			Before:     string(e.Before),
+			After:      string(e.After),
+			ClientIp:   e.ClientIp.String,
+			CreatedAt:  e.CreatedAt.Time.Format(time.RFC3339),
+		}
+		if e.ActorID.Valid {
+			entries[i].ActorId = uuid.UUID(e.ActorID.Bytes).String()
+		}
+	}
+
+	return &pb.ListAuditEntriesResponse{Entries: entries}, nil
+}
+
+// ---------------------- MODERATION ----------------------
+
+// ListModerationQueue - Ko'rib chiqilishi kutilayotgan elementlar (faqat admin)
+func (s *CarService) ListModerationQueue(ctx context.Context, req *pb.ListModerationQueueRequest) (*pb.ListModerationItemsResponse, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. Navbatni olish
+	dbItems, err := s.store.ListPendingModeration(ctx, sqlc.ListPendingModerationParams{
+		EntityType: zero.StringFrom(req.GetEntityType()),
+		Offset:     pgtype.Int4{Int32: req.GetOffset(), Valid: req.GetOffset() != 0},
+		Limit:      pgtype.Int4{Int32: req.GetLimit(), Valid: req.GetLimit() != 0},
+	})
+	if err != nil {
+		s.logger.Error("failed to list moderation queue", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list moderation queue")
+	}
+
+	// 3. Konvertatsiya
+	items := make([]*pb.ModerationItem, len(dbItems))
+	for i, item := range dbItems {
+		items[i] = convertModerationItemToProto(item)
+	}
+
+	return &pb.ListModerationItemsResponse{Items: items}, nil
+}
+
+// ListImageMatches - Boshqa egalarning e'lonlaridagi deyarli bir xil rasmlar
+func (s *CarService) ListImageMatches(ctx context.Context, req *pb.ListImageMatchesRequest) (*pb.ListImageMatchesResponse, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. Validatsiya
+	arg := sqlc.ListImageMatchesParams{
+		Offset: pgtype.Int4{Int32: req.GetOffset(), Valid: req.GetOffset() != 0},
+		Limit:  pgtype.Int4{Int32: req.GetLimit(), Valid: req.GetLimit() != 0},
+	}
+	if req.GetCarId() != "" {
+		carUUID, err := uuid.Parse(req.GetCarId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+		}
+		arg.CarID = pgtype.UUID{Bytes: carUUID, Valid: true}
+	}
+
+	// 3. Mosliklarni olish
+	dbMatches, err := s.store.ListImageMatches(ctx, arg)
+	if err != nil {
+		s.logger.Error("failed to list image matches", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list image matches")
+	}
+
+	// 4. Konvertatsiya
+	matches := make([]*pb.ImageMatch, len(dbMatches))
+	for i, m := range dbMatches {
+		matches[i] = s.convertImageMatchToProto(m)
+	}
+
+	return &pb.ListImageMatchesResponse{Matches: matches}, nil
+}
+
+// ApproveModerationItem - Elementni tasdiqlash
+func (s *CarService) ApproveModerationItem(ctx context.Context, req *pb.ModerationDecisionRequest) (*pb.Empty, error) {
+	return s.decideModeration(ctx, "ApproveModerationItem", req, moderationApproved)
+}
+
+// RejectModerationItem - Elementni sabab bilan rad etish
+func (s *CarService) RejectModerationItem(ctx context.Context, req *pb.ModerationDecisionRequest) (*pb.Empty, error) {
+	return s.decideModeration(ctx, "RejectModerationItem", req, moderationRejected)
+}
+
+// RequestModerationChanges - Egadan o'zgartirish kiritishni so'rash
+func (s *CarService) RequestModerationChanges(ctx context.Context, req *pb.ModerationDecisionRequest) (*pb.Empty, error) {
+	return s.decideModeration(ctx, "RequestModerationChanges", req, moderationChangesRequested)
+}
+
+// decideModeration - qarorni saqlash, obyekt holatini yangilash va egaga xabar berish
+func (s *CarService) decideModeration(ctx context.Context, rpc string, req *pb.ModerationDecisionRequest, decision string) (*pb.Empty, error) {
+	// 1. Ruxsat tekshiruvi
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Validatsiya
+	itemUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid moderation item ID format")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if decision != moderationApproved && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	itemID := pgtype.UUID{Bytes: itemUUID, Valid: true}
+	item, err := s.store.GetModerationItem(ctx, itemID)
+	if err != nil {
+		s.logger.Error("moderation item not found", "error", err)
+		return nil, status.Error(codes.NotFound, "moderation item not found")
+	}
+	entityUUID, err := uuid.Parse(item.EntityID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid entity ID")
+	}
+	ownerUUID, err := uuid.Parse(item.OwnerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid owner ID")
+	}
+
+	// 3. Qaror, obyekt holati, bildirishnoma va audit bitta tranzaksiyada
+	notifType, notifMessage := moderationNotification(decision, item.EntityType, reason)
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		n, err := q.ReviewModerationItem(ctx, sqlc.ReviewModerationItemParams{
+			Status:     decision,
+			Reason:     zero.StringFrom(reason),
+			ReviewedBy: pgtype.UUID{Bytes: adminUUID, Valid: true},
+			ID:         itemID,
+		})
+		if err != nil {
+			return fmt.Errorf("review moderation item: %w", err)
+		}
+		if n == 0 {
+			return errAlreadyReviewed
+		}
+		if err := setModerationStatus(ctx, q, item.EntityType, pgtype.UUID{Bytes: entityUUID, Valid: true}, decision); err != nil {
+			return fmt.Errorf("set moderation status: %w", err)
+		}
+		if _, err := q.CreateNotification(ctx, sqlc.CreateNotificationParams{
+			UserID:  pgtype.UUID{Bytes: ownerUUID, Valid: true},
+			Type:    zero.StringFrom(notifType),
+			Message: zero.StringFrom(notifMessage),
+		}); err != nil {
+			return fmt.Errorf("create notification: %w", err)
+		}
+		return s.audit(ctx, q, rpc, item.EntityType, item.EntityID,
+			map[string]interface{}{"moderation_status": item.Status},
+			map[string]interface{}{"moderation_status": decision, "reason": reason},
+		)
+	})
+	if err != nil {
+		if errors.Is(err, errAlreadyReviewed) {
+			return nil, status.Error(codes.FailedPrecondition, "moderation item already reviewed")
+		}
+		s.logger.Error("failed to review moderation item", "id", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, "failed to review moderation item")
+	}
+
+	// 4. Push xabar (xatolar faqat log qilinadi)
+	if err := s.sendPushNotification(ctx, ownerUUID, notifType, notifMessage); err != nil {
+		s.logger.Warn("failed to push moderation notification", "user_id", item.OwnerID, "error", err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ---------------------- REPORTS ----------------------
+
+// ReportContent - Mashina, kommentariya, sharh yoki xabar haqida shikoyat qilish
+func (s *CarService) ReportContent(ctx context.Context, req *pb.ReportContentRequest) (*pb.Report, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Validatsiya
+	entityUUID, err := uuid.Parse(req.GetEntityId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid entity ID format")
+	}
+	if !reportReasons[req.GetReason()] {
+		return nil, status.Error(codes.InvalidArgument, "reason must be one of spam, scam, abuse, inappropriate, other")
+	}
+
+	entityID := pgtype.UUID{Bytes: entityUUID, Valid: true}
+	reporterID := pgtype.UUID{Bytes: userUUID, Valid: true}
+	if err := s.checkReportable(ctx, req.GetEntityType(), entityID, reporterID); err != nil {
+		return nil, err
+	}
+
+	// 3. Shikoyatni saqlash, chegaraga yetgan kontentni yashirish va audit
+	var report *pb.Report
+	var hidden bool
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbReport, err := q.CreateReport(ctx, sqlc.CreateReportParams{
+			EntityType: req.GetEntityType(),
+			EntityID:   entityID,
+			ReporterID: reporterID,
+			Reason:     req.GetReason(),
+			Details:    zero.StringFrom(req.GetDetails()),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errAlreadyReported
+			}
+			return fmt.Errorf("create report: %w", err)
+		}
+
+		reporters, err := q.CountOpenReporters(ctx, sqlc.CountOpenReportersParams{
+			EntityType: req.GetEntityType(),
+			EntityID:   entityID,
+		})
+		if err != nil {
+			return fmt.Errorf("count reporters: %w", err)
+		}
+		if s.reportHideThreshold > 0 && reporters >= int64(s.reportHideThreshold) {
+			if err := hideReportedContent(ctx, q, req.GetEntityType(), entityID); err != nil {
+				return fmt.Errorf("hide content: %w", err)
+			}
+			hidden = true
+		}
+		report = s.convertDBReportToProto(dbReport)
+		return s.audit(ctx, q, "ReportContent", entityReport, report.Id, nil, report)
+	})
+	if err != nil {
+		if errors.Is(err, errAlreadyReported) {
+			return nil, status.Error(codes.AlreadyExists, "you already have an unresolved report on this content")
+		}
+		s.logger.Error("failed to report content", "error", err)
+		return nil, status.Error(codes.Internal, "failed to report content")
+	}
+	if hidden {
+		s.logger.Info("content hidden after reports", "entity_type", req.GetEntityType(), "entity_id", req.GetEntityId())
+	}
+
+	return report, nil
+}
+
+// ListReports - Shikoyatlar ro'yxati (faqat admin)
+func (s *CarService) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. Ma'lumotlarni olish
+	dbReports, err := s.store.ListReports(ctx, sqlc.ListReportsParams{
+		Status:     zero.StringFrom(req.GetStatus()),
+		EntityType: zero.StringFrom(req.GetEntityType()),
+		Offset:     pgtype.Int4{Int32: req.GetOffset(), Valid: req.GetOffset() != 0},
+		Limit:      pgtype.Int4{Int32: req.GetLimit(), Valid: req.GetLimit() != 0},
+	})
+	if err != nil {
+		s.logger.Error("failed to list reports", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reports")
+	}
+
+	// 3. Konvertatsiya
+	reports := make([]*pb.Report, len(dbReports))
+	for i, dbReport := range dbReports {
+		reports[i] = s.convertDBReportToProto(dbReport)
+	}
+
+	return &pb.ListReportsResponse{Reports: reports}, nil
+}
+
+// TriageReport - Ochiq shikoyatni ko'rib chiqilmoqda deb belgilash (faqat admin)
+func (s *CarService) TriageReport(ctx context.Context, req *pb.TriageReportRequest) (*pb.Empty, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. UUID konvertatsiyasi
+	reportUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID format")
+	}
+	id := pgtype.UUID{Bytes: reportUUID, Valid: true}
+
+	before, err := s.store.GetReport(ctx, id)
+	if err != nil {
+		s.logger.Error("report not found", "error", err)
+		return nil, status.Error(codes.NotFound, "report not found")
+	}
+
+	// 3. Yangilash va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		n, err := q.TriageReport(ctx, sqlc.TriageReportParams{
+			Note: zero.StringFrom(req.GetNote()),
+			ID:   id,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errReportNotOpen
+		}
+		return s.audit(ctx, q, "TriageReport", entityReport, req.GetId(),
+			map[string]interface{}{"status": before.Status},
+			map[string]interface{}{"status": reportStatusTriaged, "note": req.GetNote()},
+		)
+	})
+	if errors.Is(err, errReportNotOpen) {
+		return nil, status.Error(codes.FailedPrecondition, "report is not open")
+	}
+	if err != nil {
+		s.logger.Error("failed to triage report", "error", err)
+		return nil, status.Error(codes.Internal, "failed to triage report")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ResolveReport - Obyekt bo'yicha barcha ochiq shikoyatlarni natija bilan yopish (faqat admin)
+func (s *CarService) ResolveReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.Empty, error) {
+	// 1. Ruxsat tekshiruvi
+	adminID, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Validatsiya
+	reportUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID format")
+	}
+	outcome := req.GetOutcome()
+	if outcome != reportOutcomeDismissed && outcome != reportOutcomeContentRemoved {
+		return nil, status.Error(codes.InvalidArgument, "outcome must be dismissed or content_removed")
+	}
+
+	report, err := s.store.GetReport(ctx, pgtype.UUID{Bytes: reportUUID, Valid: true})
+	if err != nil {
+		s.logger.Error("report not found", "error", err)
+		return nil, status.Error(codes.NotFound, "report not found")
+	}
+	if report.Status == reportStatusResolved {
+		return nil, status.Error(codes.FailedPrecondition, "report already resolved")
+	}
+	entityUUID, err := uuid.Parse(report.EntityID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid entity ID")
+	}
+	entityID := pgtype.UUID{Bytes: entityUUID, Valid: true}
+
+	// 3. Shikoyatlarni yopish, natijani kontentga qo'llash va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		resolved, err := q.ResolveReportsByEntity(ctx, sqlc.ResolveReportsByEntityParams{
+			Outcome:    zero.StringFrom(outcome),
+			Note:       zero.StringFrom(req.GetNote()),
+			ResolvedBy: pgtype.UUID{Bytes: adminUUID, Valid: true},
+			EntityType: report.EntityType,
+			EntityID:   entityID,
+		})
+		if err != nil {
+			return fmt.Errorf("resolve reports: %w", err)
+		}
+		if err := applyReportOutcome(ctx, q, report.EntityType, entityID, outcome); err != nil {
+			return fmt.Errorf("apply outcome: %w", err)
+		}
+		return s.audit(ctx, q, "ResolveReport", entityReport, req.GetId(),
+			map[string]interface{}{"status": report.Status},
+			map[string]interface{}{"status": reportStatusResolved, "outcome": outcome, "note": req.GetNote(), "resolved_reports": resolved},
+		)
+	})
+	if err != nil {
+		s.logger.Error("failed to resolve report", "id", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve report")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ---------------------- PRICE ESTIMATE ----------------------
+
+// EstimateCarPrice - O'xshash e'lonlar asosida bozor narxi oralig'i
+func (s *CarService) EstimateCarPrice(ctx context.Context, req *pb.EstimateCarPriceRequest) (*pb.PriceEstimate, error) {
+	carMake, model, year, mileage, location := req.GetMake(), req.GetModel(), req.GetYear(), req.GetMileage(), req.GetLocation()
+	var excludeID pgtype.UUID
+	var carPrice float64
+
+	// 1. Mavjud e'lon uchun uning o'z ma'lumotlari ishlatiladi
+	if req.GetCarId() != "" {
+		carUUID, err := uuid.Parse(req.GetCarId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+		}
+		excludeID = pgtype.UUID{Bytes: carUUID, Valid: true}
+
+		dbCar, err := s.store.GetCarById(ctx, excludeID)
+		if err != nil {
+			s.logger.Error("car not found", "error", err)
+			return nil, status.Error(codes.NotFound, "car not found")
+		}
+		// GetCarById dagi kabi: tasdiqlanmagan e'lon boshqalar uchun mavjud emasdek ko'rinadi
+		if dbCar.ModerationStatus != moderationApproved && !canViewUnmoderated(ctx, dbCar.OwnerID) {
+			return nil, status.Error(codes.NotFound, "car not found")
+		}
+		carMake, model, year, mileage, location = dbCar.Make, dbCar.Model, dbCar.Year, dbCar.Mileage, dbCar.Location
+		carPrice, _ = convertNumericToFloat(dbCar.Price)
+	}
+
+	// 2. Validatsiya
+	if carMake == "" || model == "" || year <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "make, model and year are required")
+	}
+	if mileage < 0 {
+		return nil, status.Error(codes.InvalidArgument, "mileage must not be negative")
+	}
+
+	// 3. Hisoblash
+	est, locationMatched, err := s.estimatePrice(ctx, carMake, model, year, mileage, location, excludeID)
+	if err != nil {
+		s.logger.Error("failed to estimate car price", "error", err)
+		return nil, status.Error(codes.Internal, "failed to estimate car price")
+	}
+
+	res := &pb.PriceEstimate{
+		Comparables:     int32(est.Comparables),
+		LocationMatched: locationMatched,
+		Sufficient:      est.Comparables >= minPriceComparables,
+	}
+	if !res.Sufficient {
+		return res, nil
+	}
+
+	res.LowPrice = est.LowPrice
+	res.MedianPrice = est.MedianPrice
+	res.HighPrice = est.HighPrice
+	if excludeID.Valid {
+		res.PriceBadge = priceBadge(carPrice, est)
+	}
+
+	return res, nil
+}
+
+// ---------------------- CATALOG ----------------------
+
+// UpsertCatalogEntry - Marka, model yoki avlodni aliaslari bilan yaratish/yangilash (faqat admin)
+func (s *CarService) UpsertCatalogEntry(ctx context.Context, req *pb.CatalogEntryRequest) (*pb.CatalogEntry, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. Validatsiya
+	name := strings.TrimSpace(req.GetName())
+	nameKey := catalogKey(name)
+	if nameKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	entry := &pb.CatalogEntry{Kind: req.GetKind()}
+	var entityType string
+
+	switch req.GetKind() {
+	case catalogKindMake:
+		entityType = entityCarMake
+	case catalogKindModel:
+		entityType = entityCarModel
+		if strings.TrimSpace(req.GetMake()) == "" {
+			return nil, status.Error(codes.InvalidArgument, "make is required")
+		}
+	case catalogKindGeneration:
+		entityType = entityCarGeneration
+		if strings.TrimSpace(req.GetMake()) == "" || strings.TrimSpace(req.GetModel()) == "" {
+			return nil, status.Error(codes.InvalidArgument, "make and model are required")
+		}
+		if req.GetYearFrom() <= 0 || (req.GetYearTo() != 0 && req.GetYearTo() < req.GetYearFrom()) {
+			return nil, status.Error(codes.InvalidArgument, "invalid generation years")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "kind must be make, model or generation")
+	}
+
+	// 3. Katalogga yozish va audit
+	err := s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		switch req.GetKind() {
+		case catalogKindMake:
+			dbMake, err := q.UpsertCarMake(ctx, sqlc.UpsertCarMakeParams{Name: name, NameKey: nameKey})
+			if err != nil {
+				return fmt.Errorf("upsert make: %w", err)
+			}
+			makeUUID, err := uuid.Parse(dbMake.ID)
+			if err != nil {
+				return fmt.Errorf("parse make id: %w", err)
+			}
+			entry.Id, entry.Name, entry.Make = dbMake.ID, dbMake.Name, dbMake.Name
+			makeID := pgtype.UUID{Bytes: makeUUID, Valid: true}
+			entry.Aliases = catalogAliasKeys(req.GetAliases(), nameKey)
+			for _, key := range entry.Aliases {
+				// Boshqa markaning nomi bilan bir xil alias aniqlashni noaniq qiladi
+				taken, err := q.CarMakeKeyTaken(ctx, sqlc.CarMakeKeyTakenParams{Key: key, MakeID: makeID})
+				if err != nil {
+					return fmt.Errorf("check make alias: %w", err)
+				}
+				if taken {
+					return status.Error(codes.AlreadyExists, fmt.Sprintf("alias %q is the name of another make", key))
+				}
+				owner, err := q.AddCarMakeAlias(ctx, sqlc.AddCarMakeAliasParams{
+					AliasKey: key,
+					MakeID:   makeID,
+				})
+				if err != nil {
+					return fmt.Errorf("add make alias: %w", err)
+				}
+				// Boshqa markaning taxallusi jimgina ko'chirilmaydi, aks holda mavjud e'lonlar normalizatsiyasi o'zgaradi
+				if owner != dbMake.ID {
+					return status.Error(codes.AlreadyExists, fmt.Sprintf("alias %q already belongs to another make", key))
+				}
+			}
+
+		case catalogKindModel:
+			dbMake, makeID, err := resolveCatalogMake(ctx, q, req.GetMake())
+			if err != nil {
+				return err
+			}
+			dbModel, err := q.UpsertCarModel(ctx, sqlc.UpsertCarModelParams{MakeID: makeID, Name: name, NameKey: nameKey})
+			if err != nil {
+				return fmt.Errorf("upsert model: %w", err)
+			}
+			modelUUID, err := uuid.Parse(dbModel.ID)
+			if err != nil {
+				return fmt.Errorf("parse model id: %w", err)
+			}
+			entry.Id, entry.Name, entry.Make, entry.Model = dbModel.ID, dbModel.Name, dbMake.Name, dbModel.Name
+			modelID := pgtype.UUID{Bytes: modelUUID, Valid: true}
+			entry.Aliases = catalogAliasKeys(req.GetAliases(), nameKey)
+			for _, key := range entry.Aliases {
+				// Shu markadagi boshqa modelning nomi yoki aliasi qayta ishlatilmaydi
+				taken, err := q.CarModelKeyTaken(ctx, sqlc.CarModelKeyTakenParams{MakeID: makeID, Key: key, ModelID: modelID})
+				if err != nil {
+					return fmt.Errorf("check model alias: %w", err)
+				}
+				if taken {
+					return status.Error(codes.AlreadyExists, fmt.Sprintf("alias %q already names another model of this make", key))
+				}
+				if err := q.AddCarModelAlias(ctx, sqlc.AddCarModelAliasParams{
+					ModelID:  modelID,
+					AliasKey: key,
+				}); err != nil {
+					return fmt.Errorf("add model alias: %w", err)
+				}
+			}
+
+		case catalogKindGeneration:
+			dbMake, makeID, err := resolveCatalogMake(ctx, q, req.GetMake())
+			if err != nil {
+				return err
+			}
+			dbModel, modelID, err := resolveCatalogModel(ctx, q, makeID, req.GetModel())
+			if err != nil {
+				return err
+			}
+			dbGen, err := q.UpsertCarGeneration(ctx, sqlc.UpsertCarGenerationParams{
+				ModelID:  modelID,
+				Name:     name,
+				YearFrom: req.GetYearFrom(),
+				YearTo:   pgtype.Int4{Int32: req.GetYearTo(), Valid: req.GetYearTo() != 0},
+			})
+			if err != nil {
+				return fmt.Errorf("upsert generation: %w", err)
+			}
+			entry.Id, entry.Name, entry.Make, entry.Model = dbGen.ID, dbGen.Name, dbMake.Name, dbModel.Name
+			entry.YearFrom, entry.YearTo = dbGen.YearFrom, dbGen.YearTo.Int32
+		}
+		return s.audit(ctx, q, "UpsertCatalogEntry", entityType, entry.Id, nil, entry)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		s.logger.Error("failed to upsert catalog entry", "kind", req.GetKind(), "error", err)
+		return nil, status.Error(codes.Internal, "failed to upsert catalog entry")
+	}
+
+	return entry, nil
+}
+
+// DeleteCatalogEntry - Marka, model yoki avlodni o'chirish (faqat admin)
+func (s *CarService) DeleteCatalogEntry(ctx context.Context, req *pb.DeleteCatalogEntryRequest) (*pb.Empty, error) {
+	// 1. Ruxsat tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. UUID konvertatsiyasi
+	entryUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid catalog entry ID format")
+	}
+	id := pgtype.UUID{Bytes: entryUUID, Valid: true}
+
+	var entityType string
+	switch req.GetKind() {
+	case catalogKindMake:
+		entityType = entityCarMake
+	case catalogKindModel:
+		entityType = entityCarModel
+	case catalogKindGeneration:
+		entityType = entityCarGeneration
+	default:
+		return nil, status.Error(codes.InvalidArgument, "kind must be make, model or generation")
+	}
+
+	// 3. O'chirish (ichki yozuvlar va aliaslar cascade bilan o'chadi) va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		var n int64
+		var err error
+		switch req.GetKind() {
+		case catalogKindMake:
+			n, err = q.DeleteCarMake(ctx, id)
+		case catalogKindModel:
+			n, err = q.DeleteCarModel(ctx, id)
+		case catalogKindGeneration:
+			n, err = q.DeleteCarGeneration(ctx, id)
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return pgx.ErrNoRows
+		}
+		return s.audit(ctx, q, "DeleteCatalogEntry", entityType, req.GetId(), map[string]interface{}{"kind": req.GetKind()}, nil)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "catalog entry not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to delete catalog entry", "kind", req.GetKind(), "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete catalog entry")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// AutocompleteMakeModel - Prefiks bo'yicha marka va model takliflari (aliaslar ham hisobga olinadi)
+func (s *CarService) AutocompleteMakeModel(ctx context.Context, req *pb.AutocompleteMakeModelRequest) (*pb.AutocompleteMakeModelResponse, error) {
+	// 1. Validatsiya
+	prefix := catalogKey(req.GetQuery())
+	if prefix == "" {
+		return &pb.AutocompleteMakeModelResponse{}, nil
+	}
+	limit := clampLimit(req.GetLimit(), defaultAutocompleteLimit, maxAutocompleteLimit)
+
+	var suggestions []*pb.MakeModelSuggestion
+
+	// 2. Marka berilmagan bo'lsa avval markalar taklif qilinadi
+	var makeID pgtype.UUID
+	if strings.TrimSpace(req.GetMake()) == "" {
+		makes, err := s.store.AutocompleteCarMakes(ctx, sqlc.AutocompleteCarMakesParams{
+			Prefix: zero.StringFrom(prefix),
+			Limit:  pgtype.Int4{Int32: limit, Valid: true},
+		})
+		if err != nil {
+			s.logger.Error("failed to autocomplete makes", "error", err)
+			return nil, status.Error(codes.Internal, "failed to autocomplete")
+		}
+		for _, name := range makes {
+			suggestions = append(suggestions, &pb.MakeModelSuggestion{Make: name})
+		}
+	} else {
+		dbMake, err := s.store.ResolveCarMake(ctx, catalogKey(req.GetMake()))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &pb.AutocompleteMakeModelResponse{}, nil
+			}
+			s.logger.Error("failed to resolve car make", "error", err)
+			return nil, status.Error(codes.Internal, "failed to autocomplete")
+		}
+		makeUUID, err := uuid.Parse(dbMake.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "invalid make ID")
+		}
+		makeID = pgtype.UUID{Bytes: makeUUID, Valid: true}
+	}
+
+	// 3. Modellar
+	if remaining := limit - int32(len(suggestions)); remaining > 0 {
+		models, err := s.store.AutocompleteCarModels(ctx, sqlc.AutocompleteCarModelsParams{
+			MakeID: makeID,
+			Prefix: zero.StringFrom(prefix),
+			Limit:  pgtype.Int4{Int32: remaining, Valid: true},
+		})
+		if err != nil {
+			s.logger.Error("failed to autocomplete models", "error", err)
+			return nil, status.Error(codes.Internal, "failed to autocomplete")
+		}
+		for _, m := range models {
+			suggestions = append(suggestions, &pb.MakeModelSuggestion{Make: m.MakeName, Model: m.ModelName})
+		}
+	}
+
+	return &pb.AutocompleteMakeModelResponse{Suggestions: suggestions}, nil
+}
+
+// ---------------------- SEARCH ----------------------
+
+// SuggestSearch - Yozish davomida qidiruv takliflari (fon jobi quradigan indeksdan)
+func (s *CarService) SuggestSearch(ctx context.Context, req *pb.SuggestSearchRequest) (*pb.SuggestSearchResponse, error) {
+	prefix := strings.ToLower(strings.Join(strings.Fields(req.GetQuery()), " "))
+	if prefix == "" {
+		return &pb.SuggestSearchResponse{}, nil
+	}
+
+	// Faqat so'z boshidan qidiriladi: LIKE belgilari ekranlanadi va indeks ishlatiladi
+	rows, err := s.store.SuggestSearch(ctx, sqlc.SuggestSearchParams{
+		Prefix: zero.StringFrom(escapeLike(prefix)),
+		Limit:  pgtype.Int4{Int32: clampLimit(req.GetLimit(), defaultSuggestLimit, maxSuggestLimit), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to suggest search", "error", err)
+		return nil, status.Error(codes.Internal, "failed to suggest search")
+	}
+
+	suggestions := make([]*pb.SearchSuggestion, len(rows))
+	for i, row := range rows {
+		suggestions[i] = &pb.SearchSuggestion{Text: row.Term, Kind: row.Kind}
+	}
+
+	return &pb.SuggestSearchResponse{Suggestions: suggestions}, nil
+}
+
+// GetTrendingSearches - Trend oynasidagi eng ko'p qidirilgan so'rovlar. Faqat katalogdagi marka
+// va modellar katalog nomi bilan chiqadi, bitta qidiruvchining takrorlari trendni ko'tarmaydi
+func (s *CarService) GetTrendingSearches(ctx context.Context, req *pb.GetTrendingSearchesRequest) (*pb.TrendingSearchesResponse, error) {
+	rows, err := s.store.GetTrendingSearches(ctx, sqlc.GetTrendingSearchesParams{
+		Since:        pgtype.Timestamptz{Time: time.Now().Add(-s.trendingWindow), Valid: true},
+		MinSearchers: pgtype.Int4{Int32: s.trendingMinSearchers, Valid: true},
+		Limit:        pgtype.Int4{Int32: clampLimit(req.GetLimit(), defaultTrendingLimit, maxTrendingLimit), Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to get trending searches", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get trending searches")
+	}
+
+	searches := make([]*pb.TrendingSearch, len(rows))
+	for i, row := range rows {
+		searches[i] = &pb.TrendingSearch{Query: row.Query, Searches: row.Searches}
+	}
+
+	return &pb.TrendingSearchesResponse{Searches: searches}, nil
+}
+
+// ---------------------- TRANSFERS ----------------------
+
+// InitiateCarTransfer - E'lonni boshqa foydalanuvchiga o'tkazishni taklif qilish
+// (ega, e'lon tashkilotining owner/manager a'zosi yoki admin)
+func (s *CarService) InitiateCarTransfer(ctx context.Context, req *pb.InitiateCarTransferRequest) (*pb.CarTransfer, error) {
+	// 1. Autentifikatsiya
+	_, role, err := s.getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validatsiya
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	toUUID, err := uuid.Parse(req.GetToUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid recipient ID format")
+	}
+
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+	dbCar, err := s.store.GetCarById(ctx, carID)
+	if err != nil {
+		s.logger.Error("car not found", "error", err)
+		return nil, status.Error(codes.NotFound, "car not found")
+	}
+
+	// 3. Tegishlik tekshiruvi: admin va o'chirish huquqi bor tashkilot a'zolari
+	// e'lonni joriy egasi nomidan o'tkaza oladi
+	if role != roleAdmin {
+		if err := s.checkCarAccess(ctx, req.GetCarId(), carActionDelete); err != nil {
+			return nil, err
+		}
+	}
+	if dbCar.OwnerID == toUUID.String() {
+		return nil, status.Error(codes.InvalidArgument, "recipient already owns this car")
+	}
+	fromUUID, err := uuid.Parse(dbCar.OwnerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "invalid owner ID")
+	}
+
+	// 4. So'rovni saqlash va audit
+	var transfer *pb.CarTransfer
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbTransfer, err := q.CreateCarTransfer(ctx, sqlc.CreateCarTransferParams{
+			CarID:      carID,
+			FromUserID: pgtype.UUID{Bytes: fromUUID, Valid: true},
+			ToUserID:   pgtype.UUID{Bytes: toUUID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		transfer = s.convertDBCarTransferToProto(dbTransfer)
+		return s.audit(ctx, q, "InitiateCarTransfer", entityCarTransfer, transfer.Id, nil, transfer)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.AlreadyExists, "car already has a pending transfer")
+		}
+		s.logger.Error("failed to create car transfer", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create car transfer")
+	}
+
+	// 5. Qabul qiluvchiga xabar
+	s.notifyUser(ctx, transfer.ToUserId, "car_transfer_requested",
+		fmt.Sprintf("You have been offered ownership of the %s %s listing", dbCar.Make, dbCar.Model))
+
+	return transfer, nil
+}
+
+// ListCarTransfers - Joriy foydalanuvchining kiruvchi va chiquvchi o'tkazishlari
+func (s *CarService) ListCarTransfers(ctx context.Context, req *pb.ListCarTransfersRequest) (*pb.ListCarTransfersResponse, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Ma'lumotlarni olish
+	dbTransfers, err := s.store.ListCarTransfersByUser(ctx, sqlc.ListCarTransfersByUserParams{
+		UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
+		Status: zero.StringFrom(req.GetStatus()),
+	})
+	if err != nil {
+		s.logger.Error("failed to list car transfers", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list car transfers")
+	}
+
+	// 3. Konvertatsiya
+	transfers := make([]*pb.CarTransfer, len(dbTransfers))
+	for i, dbTransfer := range dbTransfers {
+		transfers[i] = s.convertDBCarTransferToProto(dbTransfer)
+	}
+
+	return &pb.ListCarTransfersResponse{Transfers: transfers}, nil
+}
+
+// AcceptCarTransfer - O'tkazishni qabul qilish: owner_id yangilanadi, rasmlar va kommentariyalar mashina bilan qoladi
+func (s *CarService) AcceptCarTransfer(ctx context.Context, req *pb.CarTransferId) (*pb.Empty, error) {
+	// 1. So'rov va ruxsat tekshiruvi (faqat qabul qiluvchi)
+	transfer, err := s.getCarTransferFor(ctx, req.GetId(), func(t *pb.CarTransfer, userID, _ string) bool {
+		return t.ToUserId == userID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transferUUID, _ := uuid.Parse(transfer.Id)
+	carUUID, _ := uuid.Parse(transfer.CarId)
+	fromUUID, _ := uuid.Parse(transfer.FromUserId)
+	toUUID, _ := uuid.Parse(transfer.ToUserId)
+	transferID := pgtype.UUID{Bytes: transferUUID, Valid: true}
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+	toID := pgtype.UUID{Bytes: toUUID, Valid: true}
+
+	// 2. Egani bitta tranzaksiyada almashtirish. Faol e'lon qabul qiluvchining rejasiga sig'ishi kerak
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		car, err := q.GetCarById(ctx, carID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errOwnerChanged
+		}
+		if err != nil {
+			return fmt.Errorf("get car: %w", err)
+		}
+		if car.Available.Bool && car.ModerationStatus != moderationRejected && car.ModerationStatus != moderationRemoved {
+			if err := enforceListingQuota(ctx, q, toID, carID); err != nil {
+				return err
+			}
+		}
+		n, err := q.RespondCarTransfer(ctx, sqlc.RespondCarTransferParams{Status: transferAccepted, ID: transferID})
+		if err != nil {
+			return fmt.Errorf("respond transfer: %w", err)
+		}
+		if n == 0 {
+			return errTransferNotPending
+		}
+		n, err = q.TransferCarOwner(ctx, sqlc.TransferCarOwnerParams{
+			ToUserID:   toID,
+			ID:         carID,
+			FromUserID: pgtype.UUID{Bytes: fromUUID, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("transfer owner: %w", err)
+		}
+		if n == 0 {
+			return errOwnerChanged
+		}
+		if err := q.TransferPendingModerationOwner(ctx, sqlc.TransferPendingModerationOwnerParams{ToUserID: toID, CarID: carID}); err != nil {
+			return fmt.Errorf("transfer moderation owner: %w", err)
+		}
+		// Egalik tarixi mashina audit trail ida saqlanadi
+		if err := s.audit(ctx, q, "AcceptCarTransfer", entityCar, transfer.CarId,
+			map[string]interface{}{"owner_id": transfer.FromUserId},
+			map[string]interface{}{"owner_id": transfer.ToUserId, "transfer_id": transfer.Id},
+		); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "AcceptCarTransfer", entityCarTransfer, transfer.Id,
+			map[string]interface{}{"status": transferPending},
+			map[string]interface{}{"status": transferAccepted},
+		)
+	})
+	switch {
+	case isQuotaError(err):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, errTransferNotPending):
+		return nil, status.Error(codes.FailedPrecondition, "transfer is not pending")
+	case errors.Is(err, errOwnerChanged):
+		// Eskirgan so'rov yangi o'tkazishlarni to'sib qolmasligi uchun bekor qilinadi
+		if _, err := s.store.RespondCarTransfer(ctx, sqlc.RespondCarTransferParams{Status: transferCancelled, ID: transferID}); err != nil {
+			s.logger.Error("failed to cancel stale car transfer", "id", transfer.Id, "error", err)
+		}
+		return nil, status.Error(codes.FailedPrecondition, "car owner changed or car deleted, transfer cancelled")
+	case err != nil:
+		s.logger.Error("failed to accept car transfer", "id", transfer.Id, "error", err)
+		return nil, status.Error(codes.Internal, "failed to accept car transfer")
+	}
+
+	// 3. Xabar
+	s.notifyUser(ctx, transfer.FromUserId, "car_transfer_accepted", "Your car transfer has been accepted")
+
+	return &pb.Empty{}, nil
+}
+
+// DeclineCarTransfer - O'tkazishni rad etish (faqat qabul qiluvchi)
+func (s *CarService) DeclineCarTransfer(ctx context.Context, req *pb.CarTransferId) (*pb.Empty, error) {
+	transfer, err := s.getCarTransferFor(ctx, req.GetId(), func(t *pb.CarTransfer, userID, _ string) bool {
+		return t.ToUserId == userID
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.closeCarTransfer(ctx, "DeclineCarTransfer", transfer, transferDeclined); err != nil {
+		return nil, err
+	}
+
+	s.notifyUser(ctx, transfer.FromUserId, "car_transfer_declined", "Your car transfer has been declined")
+	return &pb.Empty{}, nil
+}
+
+// CancelCarTransfer - O'tkazishni bekor qilish (joriy ega, o'chirish huquqi bor tashkilot a'zosi yoki admin)
+func (s *CarService) CancelCarTransfer(ctx context.Context, req *pb.CarTransferId) (*pb.Empty, error) {
+	transfer, err := s.getCarTransferFor(ctx, req.GetId(), func(t *pb.CarTransfer, userID, role string) bool {
+		return t.FromUserId == userID || role == roleAdmin || s.checkCarAccess(ctx, t.CarId, carActionDelete) == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.closeCarTransfer(ctx, "CancelCarTransfer", transfer, transferCancelled); err != nil {
+		return nil, err
+	}
+
+	s.notifyUser(ctx, transfer.ToUserId, "car_transfer_cancelled", "A car transfer offered to you has been cancelled")
+	return &pb.Empty{}, nil
+}
+
+// getCarTransferFor - kutilayotgan o'tkazishni olish va foydalanuvchi unga javob bera olishini tekshirish
+func (s *CarService) getCarTransferFor(ctx context.Context, id string, allowed func(t *pb.CarTransfer, userID, role string) bool) (*pb.CarTransfer, error) {
+	userID, role, err := s.getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transferUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transfer ID format")
+	}
+
+	dbTransfer, err := s.store.GetCarTransfer(ctx, pgtype.UUID{Bytes: transferUUID, Valid: true})
+	if err != nil {
+		s.logger.Error("car transfer not found", "error", err)
+		return nil, status.Error(codes.NotFound, "car transfer not found")
+	}
+	transfer := s.convertDBCarTransferToProto(dbTransfer)
+
+	if !allowed(transfer, userID, role) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if transfer.Status != transferPending {
+		return nil, status.Error(codes.FailedPrecondition, "transfer is not pending")
+	}
+	return transfer, nil
+}
+
+// closeCarTransfer - o'tkazishni rad etilgan yoki bekor qilingan holatga o'tkazish
+func (s *CarService) closeCarTransfer(ctx context.Context, rpc string, transfer *pb.CarTransfer, newStatus string) error {
+	transferUUID, _ := uuid.Parse(transfer.Id)
+	err := s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		n, err := q.RespondCarTransfer(ctx, sqlc.RespondCarTransferParams{
+			Status: newStatus,
+			ID:     pgtype.UUID{Bytes: transferUUID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errTransferNotPending
+		}
+		return s.audit(ctx, q, rpc, entityCarTransfer, transfer.Id,
+			map[string]interface{}{"status": transferPending},
+			map[string]interface{}{"status": newStatus},
+		)
+	})
+	if errors.Is(err, errTransferNotPending) {
+		return status.Error(codes.FailedPrecondition, "transfer is not pending")
+	}
+	if err != nil {
+		s.logger.Error("failed to update car transfer", "id", transfer.Id, "error", err)
+		return status.Error(codes.Internal, "failed to update car transfer")
+	}
+	return nil
+}
+
+// ---------------------- ORGANIZATIONS ----------------------
+
+// CreateOrganization - Diler tashkilotini yaratish, yaratuvchi owner bo'ladi
+func (s *CarService) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.Organization, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Validatsiya
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization name is required")
+	}
+
+	// 3. Tashkilot, birinchi a'zo va auditni bitta tranzaksiyada saqlash
+	creatorID := pgtype.UUID{Bytes: userUUID, Valid: true}
+	var org *pb.Organization
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		dbOrg, err := q.CreateOrganization(ctx, sqlc.CreateOrganizationParams{Name: name, CreatedBy: creatorID})
+		if err != nil {
+			return fmt.Errorf("create organization: %w", err)
+		}
+		orgUUID, err := uuid.Parse(dbOrg.ID)
+		if err != nil {
+			return fmt.Errorf("parse organization id: %w", err)
+		}
+		if err := q.UpsertOrganizationMember(ctx, sqlc.UpsertOrganizationMemberParams{
+			OrgID:  pgtype.UUID{Bytes: orgUUID, Valid: true},
+			UserID: creatorID,
+			Role:   orgRoleOwner,
+		}); err != nil {
+			return fmt.Errorf("add organization owner: %w", err)
+		}
+		org = s.convertDBOrganizationToProto(dbOrg)
+		org.Role = orgRoleOwner
+		return s.audit(ctx, q, "CreateOrganization", entityOrganization, org.Id, nil, org)
+	})
+	if err != nil {
+		s.logger.Error("failed to create organization", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create organization")
+	}
+
+	return org, nil
+}
+
+// ListMyOrganizations - Joriy foydalanuvchi a'zo bo'lgan tashkilotlar
+func (s *CarService) ListMyOrganizations(ctx context.Context, req *pb.Empty) (*pb.ListOrganizationsResponse, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Ma'lumotlarni olish
+	dbOrgs, err := s.store.ListUserOrganizations(ctx, pgtype.UUID{Bytes: userUUID, Valid: true})
+	if err != nil {
+		s.logger.Error("failed to list organizations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list organizations")
+	}
+
+	// 3. Konvertatsiya
+	orgs := make([]*pb.Organization, len(dbOrgs))
+	for i, o := range dbOrgs {
+		orgs[i] = &pb.Organization{
+			Id:   o.ID,
+			Name: o.Name,
+			Role: o.Role,
+		}
+	}
+
+	return &pb.ListOrganizationsResponse{Organizations: orgs}, nil
+}
+
+// GetOrganization - Tashkilot va uning a'zolari (a'zolar yoki admin uchun)
+func (s *CarService) GetOrganization(ctx context.Context, req *pb.OrganizationId) (*pb.Organization, error) {
+	// 1. Autentifikatsiya
+	userID, role, err := s.getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	orgUUID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization ID format")
+	}
+	orgID := pgtype.UUID{Bytes: orgUUID, Valid: true}
+
+	// 2. Tashkilotni olish
+	dbOrg, err := s.store.GetOrganization(ctx, orgID)
+	if err != nil {
+		s.logger.Error("organization not found", "error", err)
+		return nil, status.Error(codes.NotFound, "organization not found")
+	}
+
+	// 3. Tegishlik tekshiruvi
+	org := s.convertDBOrganizationToProto(dbOrg)
+	if role != roleAdmin {
+		if org.Role, err = s.orgMemberRole(ctx, orgID, pgtype.UUID{Bytes: userUUID, Valid: true}); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. A'zolar ro'yxati
+	dbMembers, err := s.store.ListOrganizationMembers(ctx, orgID)
+	if err != nil {
+		s.logger.Error("failed to list organization members", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list organization members")
+	}
+	org.Members = make([]*pb.OrganizationMember, len(dbMembers))
+	for i, m := range dbMembers {
+		org.Members[i] = &pb.OrganizationMember{
+			UserId:    m.UserID,
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt.Time.Format(time.RFC3339),
+		}
+	}
+
+	return org, nil
+}
+
+// AddOrganizationMember - A'zo qo'shish yoki rolini o'zgartirish (faqat owner)
+func (s *CarService) AddOrganizationMember(ctx context.Context, req *pb.AddOrganizationMemberRequest) (*pb.Empty, error) {
+	// 1. Validatsiya
+	if !validOrgRole(req.GetRole()) {
+		return nil, status.Error(codes.InvalidArgument, "role must be owner, manager or agent")
+	}
+	memberUUID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Tegishlik tekshiruvi
+	orgID, err := s.requireOrgOwner(ctx, req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	memberID := pgtype.UUID{Bytes: memberUUID, Valid: true}
+	before, _ := s.store.GetOrganizationMemberRole(ctx, sqlc.GetOrganizationMemberRoleParams{OrgID: orgID, UserID: memberID})
+
+	// 3. Saqlash va audit; oxirgi owner o'z rolini pasaytira olmaydi
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.UpsertOrganizationMember(ctx, sqlc.UpsertOrganizationMemberParams{
+			OrgID:  orgID,
+			UserID: memberID,
+			Role:   req.GetRole(),
+		}); err != nil {
+			return fmt.Errorf("upsert organization member: %w", err)
+		}
+		owners, err := q.CountOrganizationOwners(ctx, orgID)
+		if err != nil {
+			return fmt.Errorf("count organization owners: %w", err)
+		}
+		if owners == 0 {
+			return errLastOrgOwner
+		}
+		return s.audit(ctx, q, "AddOrganizationMember", entityOrganization, req.GetOrgId(),
+			map[string]interface{}{"user_id": req.GetUserId(), "role": before},
+			map[string]interface{}{"user_id": req.GetUserId(), "role": req.GetRole()},
+		)
+	})
+	if err != nil {
+		if errors.Is(err, errLastOrgOwner) {
+			return nil, status.Error(codes.FailedPrecondition, "organization must keep at least one owner")
+		}
+		s.logger.Error("failed to add organization member", "error", err)
+		return nil, status.Error(codes.Internal, "failed to add organization member")
+	}
+
+	// 4. Yangi a'zoga xabar
+	if before == "" {
+		s.notifyUser(ctx, req.GetUserId(), "organization_member_added",
+			fmt.Sprintf("You have been added to an organization as %s", req.GetRole()))
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// RemoveOrganizationMember - A'zoni chiqarish (faqat owner, oxirgi owner chiqarilmaydi)
+func (s *CarService) RemoveOrganizationMember(ctx context.Context, req *pb.RemoveOrganizationMemberRequest) (*pb.Empty, error) {
+	// 1. Validatsiya
+	memberUUID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	// 2. Tegishlik tekshiruvi
+	orgID, err := s.requireOrgOwner(ctx, req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	memberID := pgtype.UUID{Bytes: memberUUID, Valid: true}
+
+	// 3. O'chirish va audit
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		before, err := q.GetOrganizationMemberRole(ctx, sqlc.GetOrganizationMemberRoleParams{OrgID: orgID, UserID: memberID})
+		if err != nil {
+			return errNotOrgMember
+		}
+		if _, err := q.RemoveOrganizationMember(ctx, sqlc.RemoveOrganizationMemberParams{OrgID: orgID, UserID: memberID}); err != nil {
+			return fmt.Errorf("remove organization member: %w", err)
+		}
+		owners, err := q.CountOrganizationOwners(ctx, orgID)
+		if err != nil {
+			return fmt.Errorf("count organization owners: %w", err)
+		}
+		if owners == 0 {
+			return errLastOrgOwner
+		}
+		return s.audit(ctx, q, "RemoveOrganizationMember", entityOrganization, req.GetOrgId(),
+			map[string]interface{}{"user_id": req.GetUserId(), "role": before}, nil)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errNotOrgMember):
+			return nil, status.Error(codes.NotFound, "member not found")
+		case errors.Is(err, errLastOrgOwner):
+			return nil, status.Error(codes.FailedPrecondition, "organization must keep at least one owner")
+		}
+		s.logger.Error("failed to remove organization member", "error", err)
+		return nil, status.Error(codes.Internal, "failed to remove organization member")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// AssignCarToOrganization - E'lonni tashkilotga biriktirish yoki ajratish.
+// Faqat e'lon egasi, va u tashkilotda owner yoki manager bo'lishi kerak
+func (s *CarService) AssignCarToOrganization(ctx context.Context, req *pb.AssignCarToOrganizationRequest) (*pb.Empty, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	carUUID, err := uuid.Parse(req.GetCarId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	carID := pgtype.UUID{Bytes: carUUID, Valid: true}
+
+	// 2. Faqat e'lon egasi
+	dbCar, err := s.store.GetCarById(ctx, carID)
+	if err != nil {
+		s.logger.Error("car not found", "error", err)
+		return nil, status.Error(codes.NotFound, "car not found")
+	}
+	if dbCar.OwnerID != userID {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	// 3. Yangi tashkilotdagi rol (bo'sh ID - tashkilotdan ajratish)
+	var orgID pgtype.UUID
+	if req.GetOrganizationId() != "" {
+		orgUUID, err := uuid.Parse(req.GetOrganizationId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid organization ID format")
+		}
+		orgID = pgtype.UUID{Bytes: orgUUID, Valid: true}
+		role, err := s.orgMemberRole(ctx, orgID, pgtype.UUID{Bytes: userUUID, Valid: true})
+		if err != nil {
+			return nil, err
+		}
+		if role != orgRoleOwner && role != orgRoleManager {
+			return nil, status.Error(codes.PermissionDenied, "owner or manager role required")
+		}
+	}
+
+	// 4. Saqlash va audit
+	before := ""
+	if dbCar.OrganizationID.Valid {
+		before = uuid.UUID(dbCar.OrganizationID.Bytes).String()
+	}
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.SetCarOrganization(ctx, sqlc.SetCarOrganizationParams{OrganizationID: orgID, ID: carID}); err != nil {
+			return err
+		}
+		return s.audit(ctx, q, "AssignCarToOrganization", entityCar, req.GetCarId(),
+			map[string]interface{}{"organization_id": before},
+			map[string]interface{}{"organization_id": req.GetOrganizationId()},
+		)
+	})
+	if err != nil {
+		s.logger.Error("failed to assign car to organization", "error", err)
+		return nil, status.Error(codes.Internal, "failed to assign car to organization")
+	}
+
+	return &pb.Empty{}, nil
+}
+
+// ListOrganizationMessages - Tashkilot e'lonlari bo'yicha xabarlar (faqat a'zolar)
+func (s *CarService) ListOrganizationMessages(ctx context.Context, req *pb.ListOrganizationMessagesRequest) (*pb.OrganizationMessagesResponse, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	orgUUID, err := uuid.Parse(req.GetOrgId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization ID format")
+	}
+	orgID := pgtype.UUID{Bytes: orgUUID, Valid: true}
+
+	limit, offset := req.GetLimit(), req.GetOffset()
+	if limit < 0 || offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultOrgMessagePageSize
+	}
+	if limit > maxOrgMessagePageSize {
+		limit = maxOrgMessagePageSize
+	}
+
+	// 2. Tegishlik tekshiruvi
+	if _, err := s.orgMemberRole(ctx, orgID, pgtype.UUID{Bytes: userUUID, Valid: true}); err != nil {
+		return nil, err
+	}
+
+	// 3. Ma'lumotlarni olish
+	dbMessages, err := s.store.ListOrganizationMessages(ctx, sqlc.ListOrganizationMessagesParams{
+		OrgID:  orgID,
+		Offset: pgtype.Int4{Int32: offset, Valid: true},
+		Limit:  pgtype.Int4{Int32: limit, Valid: true},
+	})
+	if err != nil {
+		s.logger.Error("failed to list organization messages", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve messages")
+	}
+
+	// 4. Konvertatsiya
+	messages := make([]*pb.Message, len(dbMessages))
+	for i, m := range dbMessages {
+		messages[i] = &pb.Message{
+			Id:          m.ID,
+			SenderId:    m.SenderID,
+			RecipientId: m.RecipientID,
+			Content:     m.Content,
+			Read:        m.Read.Bool,
+			CreatedAt:   m.CreatedAt.Time.Format(time.RFC3339),
+			CarId:       uuid.UUID(m.CarID.Bytes).String(),
+		}
+	}
+
+	return &pb.OrganizationMessagesResponse{Messages: messages}, nil
+}
+
+// requireOrgOwner - joriy foydalanuvchi tashkilot owner i ekanligini tekshirish
+func (s *CarService) requireOrgOwner(ctx context.Context, orgID string) (pgtype.UUID, error) {
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return pgtype.UUID{}, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return pgtype.UUID{}, status.Error(codes.InvalidArgument, "invalid organization ID format")
+	}
+	id := pgtype.UUID{Bytes: orgUUID, Valid: true}
+
+	role, err := s.orgMemberRole(ctx, id, pgtype.UUID{Bytes: userUUID, Valid: true})
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	if role != orgRoleOwner {
+		return pgtype.UUID{}, status.Error(codes.PermissionDenied, "organization owner role required")
+	}
+	return id, nil
+}
+
+// ---------------------- QUOTAS ----------------------
+
+// GetMyQuota - Joriy foydalanuvchi rejasi va faol e'lonlar soni
+func (s *CarService) GetMyQuota(ctx context.Context, req *pb.Empty) (*pb.Quota, error) {
+	// 1. Autentifikatsiya
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	id := pgtype.UUID{Bytes: userUUID, Valid: true}
+
+	// 2. Reja va foydalanish
+	plan, err := s.store.GetUserPlan(ctx, id)
+	if err != nil {
+		s.logger.Error("failed to get user plan", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get quota")
+	}
+	active, err := s.store.CountActiveListings(ctx, sqlc.CountActiveListingsParams{OwnerID: id})
+	if err != nil {
+		s.logger.Error("failed to count active listings", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get quota")
+	}
+
+	return &pb.Quota{
+		Plan:              plan.Code,
+		PlanName:          plan.Name,
+		MaxActiveListings: plan.MaxActiveListings,
+		ActiveListings:    int32(active),
+		MaxImagesPerCar:   plan.MaxImagesPerCar,
+	}, nil
+}
+
+// SetUserPlan - Foydalanuvchiga reja biriktirish (faqat admin)
+func (s *CarService) SetUserPlan(ctx context.Context, req *pb.SetUserPlanRequest) (*pb.Empty, error) {
+	// 1. Admin tekshiruvi
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 2. Validatsiya
+	userUUID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	var expiresAt pgtype.Timestamptz
+	if req.GetExpiresAt() != "" {
+		t, err := time.Parse(time.RFC3339, req.GetExpiresAt())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be RFC3339")
+		}
+		expiresAt = pgtype.Timestamptz{Time: t, Valid: true}
+	}
+
+	// 3. Saqlash va audit
+	id := pgtype.UUID{Bytes: userUUID, Valid: true}
+	before, _ := s.store.GetUserPlan(ctx, id)
+	err = s.store.ExecTx(ctx, func(q *sqlc.Queries) error {
+		n, err := q.SetUserPlan(ctx, sqlc.SetUserPlanParams{
+			UserID:    id,
+			ExpiresAt: expiresAt,
+			PlanCode:  req.GetPlan(),
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return pgx.ErrNoRows
+		}
+		return s.audit(ctx, q, "SetUserPlan", entityUserPlan, req.GetUserId(),
+			map[string]interface{}{"plan": before.Code},
+			map[string]interface{}{"plan": req.GetPlan(), "expires_at": req.GetExpiresAt()},
+		)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to set user plan", "error", err)
+		return nil, status.Error(codes.Internal, "failed to set user plan")
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -2,56 +2,110 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"math/big"
+	"net"
+	"net/http"
+	"net/netip"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 	"wegugin/auth"
 	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
 	"wegugin/storage/postgres/sqlc"
 
+	firebase "firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	zero "gopkg.in/guregu/null.v4/zero"
 )
 
 type imageData struct {
-	ID         string `json:"id"`
-	CarID      string `json:"car_id"`
-	Filename   string `json:"filename"`
-	UploadedAt string `json:"uploaded_at"`
+	ID         string   `json:"id"`
+	CarID      string   `json:"car_id"`
+	Filename   string   `json:"filename"`
+	UploadedAt string   `json:"uploaded_at"`
+	Variants   []string `json:"variants"`
+	Position   int32    `json:"position"`
+	IsCover    bool     `json:"is_cover"`
+	Width      *int32   `json:"width"`
+	Height     *int32   `json:"height"`
 }
 
+const roleAdmin = "admin"
+
 // ---------------------- HELPER FUNCTIONS ----------------------
 func (s *CarService) getUserIDFromContext(ctx context.Context) (string, error) {
+	userID, _, err := s.getUserFromContext(ctx)
+	return userID, err
+}
+
+// getUserFromContext - tokendan foydalanuvchi ID si va rolini olish
+func (s *CarService) getUserFromContext(ctx context.Context) (string, string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		s.logger.Error("metadata not found in context")
-		return "", status.Error(codes.Unauthenticated, "authentication required")
+		return "", "", status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	authHeader := md.Get("Authorization")
-	if len(authHeader) == 0 {
-		authHeader = md.Get("authorization")
-	}
-	if len(authHeader) == 0 {
+	token, ok := tokenFromMetadata(md)
+	if !ok {
 		s.logger.Error("Authorization header missing")
-		return "", status.Error(codes.Unauthenticated, "Authorization token required")
+		return "", "", status.Error(codes.Unauthenticated, "Authorization token required")
 	}
 
-	token := strings.TrimPrefix(authHeader[0], "Bearer ")
-	userID, _, err := auth.GetUserIdFromToken(token)
+	userID, role, err := auth.GetUserIdFromToken(token)
 	if err != nil {
 		s.logger.Error("invalid token", "error", err)
-		return "", status.Error(codes.Unauthenticated, "invalid token")
+		return "", "", status.Error(codes.Unauthenticated, "invalid token")
 	}
 
-	return userID, nil
+	return userID, role, nil
+}
+
+func tokenFromMetadata(md metadata.MD) (string, bool) {
+	authHeader := md.Get("Authorization")
+	if len(authHeader) == 0 {
+		authHeader = md.Get("authorization")
+	}
+	if len(authHeader) == 0 {
+		return "", false
+	}
+	return strings.TrimPrefix(authHeader[0], "Bearer "), true
+}
+
+// isAdmin - so'rov yuboruvchi admin ekanligini tekshirish
+func (s *CarService) isAdmin(ctx context.Context) bool {
+	_, role, err := s.getUserFromContext(ctx)
+	return err == nil && role == roleAdmin
 }
 
 func (s *CarService) checkCarOwnership(ctx context.Context, carID string) error {
+	return s.checkCarAccess(ctx, carID, carActionEdit)
+}
+
+// checkCarAccess - e'lon egasi yoki tashkilotning amalga ruxsati bor a'zosi ekanligini tekshirish
+func (s *CarService) checkCarAccess(ctx context.Context, carID, action string) error {
 	userID, err := s.getUserIDFromContext(ctx)
 	if err != nil {
 		return err
@@ -61,8 +115,24 @@ func (s *CarService) checkCarOwnership(ctx context.Context, carID string) error
 		UserId: userID, // <- userID ni qo'shamiz
 		CarId:  carID,
 	})
+	if err == nil && res.Result {
+		return nil
+	}
 
-	if err != nil || !res.Result {
+	// Egasi bo'lmasa, e'lon biriktirilgan tashkilotdagi rol tekshiriladi
+	carUUID, err := uuid.Parse(carID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid car ID format")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	role, err := s.store.GetCarMemberRole(ctx, sqlc.GetCarMemberRoleParams{
+		CarID:  pgtype.UUID{Bytes: carUUID, Valid: true},
+		UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
+	})
+	if err != nil || !orgRoleAllows(role, action) {
 		return status.Error(codes.PermissionDenied, "permission denied")
 	}
 	return nil
@@ -149,7 +219,13 @@ func (s *CarService) processImages(jsonData []byte) []*pb.Image {
 					CarId:      img.CarID,
 					Filename:   img.Filename,
 					UploadedAt: img.UploadedAt,
+					Position:   img.Position,
+					IsCover:    img.IsCover,
+				}
+				if img.Width != nil && img.Height != nil {
+					images[i].Width, images[i].Height = *img.Width, *img.Height
 				}
+				s.setImageURLs(images[i], img.Variants)
 			}
 		}
 	}
@@ -174,6 +250,8 @@ func (s *CarService) convertListCarToProto(dbCar sqlc.ListCarsRow) *pb.Car {
 		OwnerId:      dbCar.OwnerID,
 		Location:     dbCar.Location,
 		ReviewsCount: int32(dbCar.ReviewsCount.Int32),
+		RatingAvg:    ratingAverage(dbCar.RatingSum, dbCar.RatingCount),
+		RatingCount:  dbCar.RatingCount,
 		Images:       s.processImages(dbCar.Images),
 		CreatedAt:    dbCar.CreatedAt.Time.Format(time.RFC3339),
 		UpdatedAt:    dbCar.UpdatedAt.Time.Format(time.RFC3339),
@@ -218,6 +296,8 @@ func (s *CarService) convertSearchCarToProto(dbCar sqlc.SearchCarRow) *pb.Car {
 		OwnerId:      dbCar.OwnerID,
 		Location:     dbCar.Location,
 		ReviewsCount: int32(dbCar.ReviewsCount.Int32),
+		RatingAvg:    ratingAverage(dbCar.RatingSum, dbCar.RatingCount),
+		RatingCount:  dbCar.RatingCount,
 		Images:       s.processImages(dbCar.Images),
 		CreatedAt:    dbCar.CreatedAt.Time.Format(time.RFC3339),
 		UpdatedAt:    dbCar.UpdatedAt.Time.Format(time.RFC3339),
@@ -228,24 +308,31 @@ func (s *CarService) convertSearchCarToProto(dbCar sqlc.SearchCarRow) *pb.Car {
 func (s *CarService) convertDBCarToProtoWithImages(dbCar sqlc.GetCarByIdRow) *pb.Car {
 	price, _ := convertNumericToFloat(dbCar.Price)
 
-	return &pb.Car{
-		Id:           dbCar.ID,
-		Type:         dbCar.Type,
-		Make:         dbCar.Make,
-		Model:        dbCar.Model,
-		Year:         dbCar.Year,
-		Color:        dbCar.Color,
-		Mileage:      dbCar.Mileage,
-		Price:        price,
-		Description:  dbCar.Description.String,
-		Available:    dbCar.Available.Bool,
-		OwnerId:      dbCar.OwnerID,
-		Location:     dbCar.Location,
-		ReviewsCount: int32(dbCar.ReviewsCount.Int32),
-		Images:       s.processImages(dbCar.Images),
-		CreatedAt:    dbCar.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:    dbCar.UpdatedAt.Time.Format(time.RFC3339),
+	car := &pb.Car{
+		Id:               dbCar.ID,
+		Type:             dbCar.Type,
+		Make:             dbCar.Make,
+		Model:            dbCar.Model,
+		Year:             dbCar.Year,
+		Color:            dbCar.Color,
+		Mileage:          dbCar.Mileage,
+		Price:            price,
+		Description:      dbCar.Description.String,
+		Available:        dbCar.Available.Bool,
+		OwnerId:          dbCar.OwnerID,
+		Location:         dbCar.Location,
+		ReviewsCount:     int32(dbCar.ReviewsCount.Int32),
+		RatingAvg:        ratingAverage(dbCar.RatingSum, dbCar.RatingCount),
+		RatingCount:      dbCar.RatingCount,
+		Images:           s.processImages(dbCar.Images),
+		CreatedAt:        dbCar.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:        dbCar.UpdatedAt.Time.Format(time.RFC3339),
+		ModerationStatus: dbCar.ModerationStatus,
+	}
+	if dbCar.OrganizationID.Valid {
+		car.OrganizationId = uuid.UUID(dbCar.OrganizationID.Bytes).String()
 	}
+	return car
 }
 
 // ---------------------- HELPER FUNCTIONS ----------------------
@@ -284,6 +371,62 @@ func (s *CarService) checkCommentOwnership(ctx context.Context, commentId string
 	return nil
 }
 
+func (s *CarService) checkImageOwnership(ctx context.Context, imageID string) error {
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	imageUUID, err := uuid.Parse(imageID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid image ID format")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	isOwner, err := s.store.CheckImageOwnership(ctx, sqlc.CheckImageOwnershipParams{
+		ID:      pgtype.UUID{Bytes: imageUUID, Valid: true},
+		OwnerID: pgtype.UUID{Bytes: userUUID, Valid: true},
+	})
+	if err == nil && isOwner {
+		return nil
+	}
+
+	// Tashkilot e'loni rasmlarini tahrirlash huquqi bor a'zolar ham boshqaradi
+	image, err := s.store.GetImageById(ctx, pgtype.UUID{Bytes: imageUUID, Valid: true})
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return s.checkCarAccess(ctx, image.CarID, carActionEdit)
+}
+
+func (s *CarService) checkNotificationOwnership(ctx context.Context, notificationID string) error {
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	notificationUUID, err := uuid.Parse(notificationID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid notification ID format")
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	isOwner, err := s.store.CheckNotificationOwnership(ctx, sqlc.CheckNotificationOwnershipParams{
+		ID:     pgtype.UUID{Bytes: notificationUUID, Valid: true},
+		UserID: pgtype.UUID{Bytes: userUUID, Valid: true},
+	})
+	if err != nil || !isOwner {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
 // ---------------------- HELPER FUNCTIONS ----------------------
 
 // SQL natijasini protobuf formatiga o'tkazish
@@ -309,6 +452,15 @@ func (s *CarService) convertDBNotificationToProto(dbNotif interface{}) *pb.Notif
 			Seen:      v.Seen.Bool,
 			CreatedAt: v.CreatedAt.Time.Format(time.RFC3339),
 		}
+	case sqlc.CreateNotificationRow:
+		notif = &pb.Notification{
+			Id:        v.ID,
+			UserId:    v.UserID,
+			Type:      v.Type,
+			Message:   v.Message,
+			Seen:      v.Seen.Bool,
+			CreatedAt: v.CreatedAt.Time.Format(time.RFC3339),
+		}
 	default:
 		s.logger.Error("unknown notification type", "type", fmt.Sprintf("%T", dbNotif))
 		return &pb.Notification{}
@@ -321,7 +473,7 @@ func (s *CarService) convertDBNotificationToProto(dbNotif interface{}) *pb.Notif
 
 // SQL natijasini protobuf formatiga o'tkazish
 func (s *CarService) convertDBMessageToProto(dbMsg sqlc.CreateMessageRow) *pb.Message {
-	return &pb.Message{
+	message := &pb.Message{
 		Id:          dbMsg.ID,
 		SenderId:    dbMsg.SenderID,
 		RecipientId: dbMsg.RecipientID,
@@ -329,6 +481,10 @@ func (s *CarService) convertDBMessageToProto(dbMsg sqlc.CreateMessageRow) *pb.Me
 		Read:        dbMsg.Read.Bool,
 		CreatedAt:   dbMsg.CreatedAt.Time.Format(time.RFC3339),
 	}
+	if dbMsg.CarID.Valid {
+		message.CarId = uuid.UUID(dbMsg.CarID.Bytes).String()
+	}
+	return message
 }
 
 // JSON ma'lumotni protobuf formatiga o'tkazish
@@ -348,3 +504,1902 @@ func (s *CarService) convertRawMessage(raw map[string]interface{}) (*pb.Message,
 		CreatedAt:   createdAt.Format(time.RFC3339),
 	}, nil
 }
+
+// sendPushNotification - foydalanuvchining barcha qurilmalariga FCM orqali xabar yuborish
+func (s *CarService) sendPushNotification(ctx context.Context, userUUID uuid.UUID, title, body string) error {
+	config := &firebase.Config{
+		ProjectID: "wegugin-cars-notifications",
+	}
+
+	// Firebase ilovasini ishga tushirish
+	opt := option.WithCredentialsFile("./firebase/wegugin-cars-notifications-firebase-adminsdk-fbsvc-0016bd1639.json")
+	app, err := firebase.NewApp(context.Background(), config, opt)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("Ilovani ishga tushirishda xatolik: %v", err))
+		return status.Error(codes.Internal, "failed to initialize Firebase app")
+	}
+	// FCM mijozini olish
+	client, err := app.Messaging(context.Background())
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("FCM mijozini olishda xatolik: %v", err))
+		return status.Error(codes.Internal, "failed to initialize FCM client")
+	}
+	resptoken, err := s.store.GetNotificationTokensByUserId(ctx, pgtype.UUID{Bytes: userUUID, Valid: true})
+	if err != nil {
+		s.logger.Error("Failed to get notification tokens", "error", err)
+		return status.Error(codes.Internal, "failed to retrieve notification tokens")
+	}
+
+	for _, v := range resptoken {
+		// Xabar yaratish
+		message := &messaging.Message{
+			Notification: &messaging.Notification{
+				Title: title,
+				Body:  body,
+			},
+			Token: v.Token,
+		}
+
+		go func(token string) {
+			_, err := client.Send(context.Background(), message)
+			if err != nil {
+				// Faqatgina xatolarni log qilamiz, lekin jarayonni to'xtatmaymiz
+				s.logger.Error("FCM xabar yuborishda xato",
+					"token", token,
+					"error", err,
+				)
+			}
+		}(v.Token)
+	}
+
+	return nil
+}
+
+// ---------------------- AUDIT ----------------------
+
+// Audit va moderatsiya yozuvlaridagi obyekt turlari
+const (
+	entityCar               = "car"
+	entitySavedCar          = "saved_car"
+	entityNotification      = "notification"
+	entityMessage           = "message"
+	entityNotificationToken = "notification_token"
+	entityImage             = "image"
+	entityMedia             = "media"
+	entityComment           = "comment"
+	entityReview            = "review"
+	entityReport            = "report"
+	entityCarMake           = "car_make"
+	entityCarModel          = "car_model"
+	entityCarGeneration     = "car_generation"
+	entityCarTransfer       = "car_transfer"
+	entityOrganization      = "organization"
+	entityUserPlan          = "user_plan"
+)
+
+// audit - mutatsiya qiluvchi RPC uchun audit yozuvini mutatsiya tranzaksiyasi ichida qo'shish.
+// before/after dan faqat o'zgargan maydonlar saqlanadi. Yozib bo'lmasa xato qaytadi va
+// tranzaksiya bekor qilinadi: audit yozuvisiz o'zgarish saqlanmaydi
+func (s *CarService) audit(ctx context.Context, q *sqlc.Queries, rpc, entityType, entityID string, before, after interface{}) error {
+	entityUUID, err := uuid.Parse(entityID)
+	if err != nil {
+		return fmt.Errorf("audit %s: parse entity id: %w", rpc, err)
+	}
+
+	beforeJSON, afterJSON, err := auditDiff(before, after)
+	if err != nil {
+		return fmt.Errorf("audit %s: diff: %w", rpc, err)
+	}
+
+	arg := sqlc.CreateAuditLogParams{
+		ActorID:    actorIDFromContext(ctx),
+		Rpc:        rpc,
+		EntityType: entityType,
+		EntityID:   pgtype.UUID{Bytes: entityUUID, Valid: true},
+		Before:     beforeJSON,
+		After:      afterJSON,
+		ClientIp:   zero.StringFrom(s.clientIPFromContext(ctx)),
+	}
+	if err := q.CreateAuditLog(ctx, arg); err != nil {
+		return fmt.Errorf("audit %s: %w", rpc, err)
+	}
+	return nil
+}
+
+// carSnapshot - audit uchun mashinaning joriy holati (topilmasa nil). Tranzaksiya ichida q
+// sifatida tranzaksiya so'rovlari beriladi
+func (s *CarService) carSnapshot(ctx context.Context, q sqlc.Querier, id pgtype.UUID) *pb.Car {
+	dbCar, err := q.GetCarById(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return s.convertDBCarToProtoWithImages(dbCar)
+}
+
+// commentSnapshot - audit uchun kommentariyaning joriy holati (topilmasa nil)
+func (s *CarService) commentSnapshot(ctx context.Context, q sqlc.Querier, id pgtype.UUID) *pb.Comment {
+	c, err := q.GetCommentById(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return &pb.Comment{
+		Id:        c.ID,
+		UserId:    c.UserID,
+		CarId:     c.CarID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// auditDiff - ikki holatni JSON ga o'tkazib, bir xil maydonlarni olib tashlaydi
+func auditDiff(before, after interface{}) ([]byte, []byte, error) {
+	b, err := toJSONMap(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	a, err := toJSONMap(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if b != nil && a != nil {
+		keys := make(map[string]struct{}, len(b)+len(a))
+		for k := range b {
+			keys[k] = struct{}{}
+		}
+		for k := range a {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			if reflect.DeepEqual(b[k], a[k]) {
+				delete(b, k)
+				delete(a, k)
+				continue
+			}
+			// omitempty sababli tushib qolgan maydonlar null bo'lib ko'rinadi
+			if _, ok := b[k]; !ok {
+				b[k] = nil
+			}
+			if _, ok := a[k]; !ok {
+				a[k] = nil
+			}
+		}
+	}
+
+	var beforeJSON, afterJSON []byte
+	if b != nil {
+		if beforeJSON, err = json.Marshal(b); err != nil {
+			return nil, nil, err
+		}
+	}
+	if a != nil {
+		if afterJSON, err = json.Marshal(a); err != nil {
+			return nil, nil, err
+		}
+	}
+	return beforeJSON, afterJSON, nil
+}
+
+func toJSONMap(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// actorIDFromContext - token bo'lsa foydalanuvchi ID si, aks holda NULL
+func actorIDFromContext(ctx context.Context) pgtype.UUID {
+	userID, _ := requesterFromContext(ctx)
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: id, Valid: true}
+}
+
+// requesterFromContext - token bo'lsa foydalanuvchi ID si va roli, bo'lmasa bo'sh qiymatlar (log yozmaydi)
+func requesterFromContext(ctx context.Context) (string, string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	token, ok := tokenFromMetadata(md)
+	if !ok {
+		return "", ""
+	}
+	userID, role, err := auth.GetUserIdFromToken(token)
+	if err != nil {
+		return "", ""
+	}
+	return userID, role
+}
+
+// clientIPFromContext - so'rov yuborgan mijoz manzili. gRPC peer ishonchli proksi (gateway) bo'lsa,
+// X-Forwarded-For o'ngdan chapga o'qiladi: gateway oxiriga HTTP peer ni qo'shadi, undan oldingi
+// qiymatlarga faqat ular ham ishonchli proksidan kelgan bo'lsa ishoniladi
+func (s *CarService) clientIPFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			return p.Addr.String()
+		}
+		return host
+	}
+	ip := addr.Addr().Unmap()
+	if !s.isTrustedProxy(ip) {
+		return ip.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	hops := strings.Split(strings.Join(md.Get("x-forwarded-for"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = hop.Unmap()
+		if !s.isTrustedProxy(ip) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+// isTrustedProxy - manzil TRUSTED_PROXIES ro'yxatidami
+func (s *CarService) isTrustedProxy(ip netip.Addr) bool {
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies - vergul bilan ajratilgan IP va CIDR lar. Noto'g'ri qiymatlar log qilinib tashlab yuboriladi
+func parseTrustedProxies(raw string, logger *slog.Logger) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, v := range strings.Split(raw, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(v); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			logger.Warn("ignoring invalid trusted proxy", "value", v)
+			continue
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes
+}
+
+// checkEntityOwnership - audit tarixini ko'rish uchun obyekt egasini tekshirish
+func (s *CarService) checkEntityOwnership(ctx context.Context, entityType, entityID string) error {
+	switch entityType {
+	case entityCar:
+		return s.checkCarOwnership(ctx, entityID)
+	case entitySavedCar:
+		return s.checkSavedCarOwnership(ctx, entityID)
+	case entityNotification:
+		return s.checkNotificationOwnership(ctx, entityID)
+	case entityImage:
+		return s.checkImageOwnership(ctx, entityID)
+	case entityComment:
+		return s.checkCommentOwnership(ctx, entityID)
+	case entityMessage, entityNotificationToken, entityReport, entityReview, entityCarMake, entityCarModel, entityCarGeneration, entityCarTransfer, entityOrganization, entityUserPlan:
+		// Bu turlar uchun tarix faqat adminlarga ochiq
+		if _, err := s.getUserIDFromContext(ctx); err != nil {
+			return err
+		}
+		return status.Error(codes.PermissionDenied, "permission denied")
+	default:
+		return status.Error(codes.InvalidArgument, "unknown entity type")
+	}
+}
+
+// ---------------------- MODERATION ----------------------
+
+const (
+	moderationModePre = "pre"
+
+	moderationPending          = "pending"
+	moderationApproved         = "approved"
+	moderationRejected         = "rejected"
+	moderationChangesRequested = "changes_requested"
+	moderationHidden           = "hidden"
+	moderationRemoved          = "removed"
+)
+
+// errAlreadyReviewed - moderatsiya elementi bo'yicha qaror allaqachon qabul qilingan
+var errAlreadyReviewed = errors.New("moderation item already reviewed")
+
+// requireAdmin - faqat adminlarga ruxsat, admin ID sini qaytaradi
+func (s *CarService) requireAdmin(ctx context.Context) (string, error) {
+	userID, role, err := s.getUserFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if role != roleAdmin {
+		return "", status.Error(codes.PermissionDenied, "admin role required")
+	}
+	return userID, nil
+}
+
+// initialModerationStatus - pre-moderatsiyada yangi yozuv tasdiqlanguncha yashirin turadi,
+// post-moderatsiyada esa darhol ko'rinadi va navbatda ko'rib chiqiladi
+func (s *CarService) initialModerationStatus() string {
+	if s.moderationMode == moderationModePre {
+		return moderationPending
+	}
+	return moderationApproved
+}
+
+// canViewUnmoderated - tasdiqlanmagan yozuvni faqat egasi va admin ko'radi
+func canViewUnmoderated(ctx context.Context, ownerID string) bool {
+	userID, role := requesterFromContext(ctx)
+	return role == roleAdmin || (userID != "" && userID == ownerID)
+}
+
+// setModerationStatus - obyekt turiga qarab moderatsiya holatini yangilash
+func setModerationStatus(ctx context.Context, q *sqlc.Queries, entityType string, id pgtype.UUID, moderationStatus string) error {
+	switch entityType {
+	case entityCar:
+		return q.SetCarModerationStatus(ctx, sqlc.SetCarModerationStatusParams{ModerationStatus: moderationStatus, ID: id})
+	case entityComment:
+		return q.SetCommentModerationStatus(ctx, sqlc.SetCommentModerationStatusParams{ModerationStatus: moderationStatus, ID: id})
+	case entityReview:
+		if err := q.SetReviewModerationStatus(ctx, sqlc.SetReviewModerationStatusParams{ModerationStatus: moderationStatus, ID: id}); err != nil {
+			return err
+		}
+		return refreshReviewRating(ctx, q, id)
+	default:
+		return fmt.Errorf("unsupported moderation entity type: %s", entityType)
+	}
+}
+
+// enqueueModeration - yozuvni boshlang'ich holatga qo'yib, moderatsiya navbatiga qo'shish
+func (s *CarService) enqueueModeration(ctx context.Context, q *sqlc.Queries, entityType string, id, ownerID pgtype.UUID) error {
+	if err := setModerationStatus(ctx, q, entityType, id, s.initialModerationStatus()); err != nil {
+		return fmt.Errorf("set moderation status: %w", err)
+	}
+	if err := q.EnqueueModeration(ctx, sqlc.EnqueueModerationParams{
+		EntityType: entityType,
+		EntityID:   id,
+		OwnerID:    ownerID,
+	}); err != nil {
+		return fmt.Errorf("enqueue moderation: %w", err)
+	}
+	return nil
+}
+
+// resubmitForModeration - rad etilgan yoki o'zgartirish so'ralgan yozuv tahrirlanganda qayta navbatga qo'yiladi.
+// Pre-moderatsiyada tasdiqlangan yozuvning tahriri ham qayta tekshiruvgacha yashiriladi.
+// Tahrir tranzaksiyasi ichida chaqiriladi: rad etilgan e'lon qayta faol e'lonlar qatoriga
+// qaytgani uchun egasining rejasi tekshiriladi
+func (s *CarService) resubmitForModeration(ctx context.Context, q *sqlc.Queries, entityType string, id, ownerID pgtype.UUID) error {
+	var current string
+	var err error
+	switch entityType {
+	case entityCar:
+		current, err = q.GetCarModerationStatus(ctx, id)
+	case entityComment:
+		current, err = q.GetCommentModerationStatus(ctx, id)
+	case entityReview:
+		current, err = q.GetReviewModerationStatus(ctx, id)
+	default:
+		return fmt.Errorf("unsupported moderation entity type: %s", entityType)
+	}
+	if err != nil {
+		return fmt.Errorf("get moderation status: %w", err)
+	}
+	switch {
+	case current == moderationRejected, current == moderationChangesRequested:
+	case current == moderationApproved && s.moderationMode == moderationModePre:
+	default:
+		return nil
+	}
+
+	if entityType == entityCar && current == moderationRejected {
+		car, err := q.GetCarById(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get car: %w", err)
+		}
+		if car.Available.Bool {
+			carOwner, err := uuid.Parse(car.OwnerID)
+			if err != nil {
+				return fmt.Errorf("parse owner id: %w", err)
+			}
+			if err := enforceListingQuota(ctx, q, pgtype.UUID{Bytes: carOwner, Valid: true}, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := setModerationStatus(ctx, q, entityType, id, s.initialModerationStatus()); err != nil {
+		return fmt.Errorf("set moderation status: %w", err)
+	}
+	if err := q.EnqueueModerationIfAbsent(ctx, sqlc.EnqueueModerationIfAbsentParams{
+		EntityType: entityType,
+		EntityID:   id,
+		OwnerID:    ownerID,
+	}); err != nil {
+		return fmt.Errorf("enqueue moderation: %w", err)
+	}
+	return nil
+}
+
+// moderationNotification - qaror bo'yicha egaga yuboriladigan bildirishnoma turi va matni
+func moderationNotification(decision, entityType, reason string) (string, string) {
+	switch decision {
+	case moderationApproved:
+		return "moderation_approved", fmt.Sprintf("Your %s has been approved", entityType)
+	case moderationRejected:
+		return "moderation_rejected", fmt.Sprintf("Your %s has been rejected: %s", entityType, reason)
+	default:
+		return "moderation_changes_requested", fmt.Sprintf("Changes were requested for your %s: %s", entityType, reason)
+	}
+}
+
+func convertModerationItemToProto(item sqlc.ListPendingModerationRow) *pb.ModerationItem {
+	return &pb.ModerationItem{
+		Id:         item.ID,
+		EntityType: item.EntityType,
+		EntityId:   item.EntityID,
+		OwnerId:    item.OwnerID,
+		Status:     item.Status,
+		CreatedAt:  item.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (s *CarService) convertImageMatchToProto(m sqlc.ListImageMatchesRow) *pb.ImageMatch {
+	return &pb.ImageMatch{
+		Id:             m.ID,
+		ImageId:        m.ImageID,
+		CarId:          m.CarID,
+		OwnerId:        m.OwnerID,
+		Url:            s.fileURL(m.Filename),
+		MatchedImageId: m.MatchedImageID,
+		MatchedCarId:   m.MatchedCarID,
+		MatchedOwnerId: m.MatchedOwnerID,
+		MatchedUrl:     s.fileURL(m.MatchedFilename),
+		Distance:       m.Distance,
+		CreatedAt:      m.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// ---------------------- REPORTS ----------------------
+
+const (
+	reportStatusTriaged  = "triaged"
+	reportStatusResolved = "resolved"
+
+	reportOutcomeDismissed      = "dismissed"
+	reportOutcomeContentRemoved = "content_removed"
+)
+
+// reportReasons - shikoyat sabablari taksonomiyasi
+var reportReasons = map[string]bool{
+	"spam":          true,
+	"scam":          true,
+	"abuse":         true,
+	"inappropriate": true,
+	"other":         true,
+}
+
+// errAlreadyReported - foydalanuvchining bu obyekt haqidagi shikoyati hali hal qilinmagan
+var errAlreadyReported = errors.New("content already reported by user")
+
+// errReportNotOpen - shikoyat allaqachon ko'rib chiqilmoqda yoki yopilgan
+var errReportNotOpen = errors.New("report is not open")
+
+// checkReportable - shikoyat qilinayotgan obyekt mavjudligi va foydalanuvchiga ko'rinishini tekshirish
+func (s *CarService) checkReportable(ctx context.Context, entityType string, id pgtype.UUID, reporterID pgtype.UUID) error {
+	reporter := uuid.UUID(reporterID.Bytes).String()
+
+	switch entityType {
+	case entityCar:
+		car, err := s.store.GetCarById(ctx, id)
+		if err != nil {
+			return status.Error(codes.NotFound, "car not found")
+		}
+		if car.OwnerID == reporter {
+			return status.Error(codes.InvalidArgument, "cannot report your own content")
+		}
+	case entityComment:
+		comment, err := s.store.GetCommentById(ctx, id)
+		if err != nil {
+			return status.Error(codes.NotFound, "comment not found")
+		}
+		if comment.UserID == reporter {
+			return status.Error(codes.InvalidArgument, "cannot report your own content")
+		}
+	case entityReview:
+		review, err := s.store.GetReviewById(ctx, id)
+		if err != nil {
+			return status.Error(codes.NotFound, "review not found")
+		}
+		if review.ReviewerID == reporter {
+			return status.Error(codes.InvalidArgument, "cannot report your own content")
+		}
+	case entityMessage:
+		// Xabar haqida faqat suhbat ishtirokchisi shikoyat qila oladi
+		ok, err := s.store.CheckMessageOwnership(ctx, sqlc.CheckMessageOwnershipParams{ID: id, UserID: reporterID})
+		if err != nil || !ok {
+			return status.Error(codes.NotFound, "message not found")
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "entity_type must be car, comment, review or message")
+	}
+	return nil
+}
+
+// hideReportedContent - shikoyatlar chegarasiga yetgan kontentni yashirish
+func hideReportedContent(ctx context.Context, q *sqlc.Queries, entityType string, id pgtype.UUID) error {
+	switch entityType {
+	case entityCar:
+		return q.TransitionCarModerationStatus(ctx, sqlc.TransitionCarModerationStatusParams{
+			ModerationStatus: moderationHidden, ID: id, FromStatus: moderationApproved,
+		})
+	case entityComment:
+		return q.TransitionCommentModerationStatus(ctx, sqlc.TransitionCommentModerationStatusParams{
+			ModerationStatus: moderationHidden, ID: id, FromStatus: moderationApproved,
+		})
+	case entityReview:
+		if err := q.TransitionReviewModerationStatus(ctx, sqlc.TransitionReviewModerationStatusParams{
+			ModerationStatus: moderationHidden, ID: id, FromStatus: moderationApproved,
+		}); err != nil {
+			return err
+		}
+		return refreshReviewRating(ctx, q, id)
+	case entityMessage:
+		return q.SetMessageHidden(ctx, sqlc.SetMessageHiddenParams{Hidden: true, ID: id})
+	default:
+		return fmt.Errorf("unsupported report entity type: %s", entityType)
+	}
+}
+
+// applyReportOutcome - dismissed kontentni qayta ko'rsatadi, content_removed esa butunlay yashiradi
+func applyReportOutcome(ctx context.Context, q *sqlc.Queries, entityType string, id pgtype.UUID, outcome string) error {
+	if outcome == reportOutcomeContentRemoved {
+		if entityType == entityMessage {
+			return q.SetMessageHidden(ctx, sqlc.SetMessageHiddenParams{Hidden: true, ID: id})
+		}
+		return setModerationStatus(ctx, q, entityType, id, moderationRemoved)
+	}
+
+	switch entityType {
+	case entityCar:
+		return q.TransitionCarModerationStatus(ctx, sqlc.TransitionCarModerationStatusParams{
+			ModerationStatus: moderationApproved, ID: id, FromStatus: moderationHidden,
+		})
+	case entityComment:
+		return q.TransitionCommentModerationStatus(ctx, sqlc.TransitionCommentModerationStatusParams{
+			ModerationStatus: moderationApproved, ID: id, FromStatus: moderationHidden,
+		})
+	case entityReview:
+		if err := q.TransitionReviewModerationStatus(ctx, sqlc.TransitionReviewModerationStatusParams{
+			ModerationStatus: moderationApproved, ID: id, FromStatus: moderationHidden,
+		}); err != nil {
+			return err
+		}
+		return refreshReviewRating(ctx, q, id)
+	case entityMessage:
+		return q.SetMessageHidden(ctx, sqlc.SetMessageHiddenParams{Hidden: false, ID: id})
+	default:
+		return fmt.Errorf("unsupported report entity type: %s", entityType)
+	}
+}
+
+// SQL natijasini protobuf formatiga o'tkazish
+func (s *CarService) convertDBReportToProto(dbReport interface{}) *pb.Report {
+	var r sqlc.GetReportRow
+
+	switch v := dbReport.(type) {
+	case sqlc.GetReportRow:
+		r = v
+	case sqlc.CreateReportRow:
+		r = sqlc.GetReportRow(v)
+	case sqlc.ListReportsRow:
+		r = sqlc.GetReportRow(v)
+	default:
+		s.logger.Error("unknown report type", "type", fmt.Sprintf("%T", dbReport))
+		return &pb.Report{}
+	}
+
+	report := &pb.Report{
+		Id:         r.ID,
+		EntityType: r.EntityType,
+		EntityId:   r.EntityID,
+		ReporterId: r.ReporterID,
+		Reason:     r.Reason,
+		Details:    r.Details.String,
+		Status:     r.Status,
+		Outcome:    r.Outcome.String,
+		Note:       r.Note.String,
+		CreatedAt:  r.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if r.ResolvedAt.Valid {
+		report.ResolvedAt = r.ResolvedAt.Time.Format(time.RFC3339)
+	}
+	return report
+}
+
+// ---------------------- PRICE ESTIMATE ----------------------
+
+const (
+	// Shundan kam o'xshash e'lon bo'lsa baho va belgi berilmaydi
+	minPriceComparables = 3
+	// O'xshash e'lonlar: yil +-2, probeg +-25% (kamida 15 000 km)
+	comparableYearRange    = 2
+	comparableMileageRatio = 0.25
+	comparableMileageMin   = 15000
+
+	priceBadgeBelow = "below_market"
+	priceBadgeAt    = "at_market"
+	priceBadgeAbove = "above_market"
+)
+
+// estimatePrice - o'xshash tasdiqlangan (sotilganlari ham) e'lonlar narxining 25/50/75 persentillari.
+// Avval shu joylashuv bo'yicha qidiriladi, yetarli bo'lmasa barcha joylashuvlar olinadi.
+func (s *CarService) estimatePrice(ctx context.Context, carMake, model string, year, mileage int32, location string, excludeID pgtype.UUID) (sqlc.GetPriceComparablesRow, bool, error) {
+	minMileage, maxMileage := comparableMileageRange(mileage)
+
+	arg := sqlc.GetPriceComparablesParams{
+		Make:       zero.StringFrom(carMake),
+		Model:      zero.StringFrom(model),
+		MinYear:    pgtype.Int4{Int32: year - comparableYearRange, Valid: true},
+		MaxYear:    pgtype.Int4{Int32: year + comparableYearRange, Valid: true},
+		MinMileage: pgtype.Int4{Int32: minMileage, Valid: true},
+		MaxMileage: pgtype.Int4{Int32: maxMileage, Valid: true},
+		Location:   zero.StringFrom(location),
+		ExcludeID:  excludeID,
+	}
+
+	est, err := s.store.GetPriceComparables(ctx, arg)
+	if err != nil {
+		return est, false, err
+	}
+	if est.Comparables >= minPriceComparables || location == "" {
+		return est, location != "", nil
+	}
+
+	arg.Location = zero.StringFrom("")
+	est, err = s.store.GetPriceComparables(ctx, arg)
+	return est, false, err
+}
+
+// comparableMileageRange - o'xshash e'lonlar uchun probeg oralig'i
+func comparableMileageRange(mileage int32) (int32, int32) {
+	tolerance := int32(float64(mileage) * comparableMileageRatio)
+	if tolerance < comparableMileageMin {
+		tolerance = comparableMileageMin
+	}
+	minMileage := mileage - tolerance
+	if minMileage < 0 {
+		minMileage = 0
+	}
+	return minMileage, mileage + tolerance
+}
+
+// priceBadge - narx 25-75 persentil oralig'idan pastmi, ichidami yoki yuqorimi
+func priceBadge(price float64, est sqlc.GetPriceComparablesRow) string {
+	switch {
+	case est.Comparables < minPriceComparables:
+		return ""
+	case price < est.LowPrice:
+		return priceBadgeBelow
+	case price > est.HighPrice:
+		return priceBadgeAbove
+	default:
+		return priceBadgeAt
+	}
+}
+
+// setPriceBadge - Car javobiga bozor narxi belgisini qo'shish (xato bo'lsa belgi qo'yilmaydi)
+func (s *CarService) setPriceBadge(ctx context.Context, car *pb.Car) {
+	carID, err := uuid.Parse(car.Id)
+	if err != nil {
+		return
+	}
+	est, _, err := s.estimatePrice(ctx, car.Make, car.Model, car.Year, car.Mileage, car.Location, pgtype.UUID{Bytes: carID, Valid: true})
+	if err != nil {
+		s.logger.Warn("failed to estimate car price", "car_id", car.Id, "error", err)
+		return
+	}
+	car.PriceBadge = priceBadge(car.Price, est)
+}
+
+// setPriceBadges - sahifadagi barcha e'lonlar uchun belgilar bitta so'rov bilan hisoblanadi.
+// Mantiq estimatePrice bilan bir xil: joylashuv bo'yicha yetarli bo'lmasa barcha joylashuvlar olinadi
+func (s *CarService) setPriceBadges(ctx context.Context, cars []*pb.Car) {
+	if len(cars) == 0 {
+		return
+	}
+	arg := sqlc.GetPriceComparablesBatchParams{
+		Ids:         make([]pgtype.UUID, 0, len(cars)),
+		Makes:       make([]string, 0, len(cars)),
+		Models:      make([]string, 0, len(cars)),
+		MinYears:    make([]int32, 0, len(cars)),
+		MaxYears:    make([]int32, 0, len(cars)),
+		MinMileages: make([]int32, 0, len(cars)),
+		MaxMileages: make([]int32, 0, len(cars)),
+		Locations:   make([]string, 0, len(cars)),
+	}
+	byID := make(map[string]*pb.Car, len(cars))
+	for _, car := range cars {
+		carUUID, err := uuid.Parse(car.Id)
+		if err != nil {
+			continue
+		}
+		minMileage, maxMileage := comparableMileageRange(car.Mileage)
+		arg.Ids = append(arg.Ids, pgtype.UUID{Bytes: carUUID, Valid: true})
+		arg.Makes = append(arg.Makes, car.Make)
+		arg.Models = append(arg.Models, car.Model)
+		arg.MinYears = append(arg.MinYears, car.Year-comparableYearRange)
+		arg.MaxYears = append(arg.MaxYears, car.Year+comparableYearRange)
+		arg.MinMileages = append(arg.MinMileages, minMileage)
+		arg.MaxMileages = append(arg.MaxMileages, maxMileage)
+		arg.Locations = append(arg.Locations, car.Location)
+		byID[carUUID.String()] = car
+	}
+
+	rows, err := s.store.GetPriceComparablesBatch(ctx, arg)
+	if err != nil {
+		s.logger.Warn("failed to estimate car prices", "error", err)
+		return
+	}
+	for _, row := range rows {
+		car, ok := byID[row.CarID]
+		if !ok {
+			continue
+		}
+		est := sqlc.GetPriceComparablesRow{Comparables: row.Comparables, LowPrice: row.LowPrice, HighPrice: row.HighPrice}
+		if car.Location != "" && row.LocalComparables >= minPriceComparables {
+			est = sqlc.GetPriceComparablesRow{Comparables: row.LocalComparables, LowPrice: row.LocalLowPrice, HighPrice: row.LocalHighPrice}
+		}
+		car.PriceBadge = priceBadge(car.Price, est)
+	}
+}
+
+// ---------------------- CATALOG ----------------------
+
+const (
+	catalogKindMake       = "make"
+	catalogKindModel      = "model"
+	catalogKindGeneration = "generation"
+
+	defaultAutocompleteLimit = 10
+	maxAutocompleteLimit     = 50
+)
+
+// catalogKey - taqqoslash kaliti: kichik harf, faqat harf va raqamlar ("B.M.W." -> "bmw")
+func catalogKey(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// normalizeMakeModel - marka va modelni katalogdagi nomga keltirish.
+// Katalogda topilmagan qiymatlar bo'sh joylari olib tashlangan holda qoladi.
+func (s *CarService) normalizeMakeModel(ctx context.Context, carMake, model string) (string, string) {
+	carMake, model = strings.TrimSpace(carMake), strings.TrimSpace(model)
+	if carMake == "" {
+		return carMake, model
+	}
+
+	dbMake, err := s.store.ResolveCarMake(ctx, catalogKey(carMake))
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("failed to resolve car make", "make", carMake, "error", err)
+		}
+		return carMake, model
+	}
+	if model == "" {
+		return dbMake.Name, model
+	}
+
+	makeUUID, err := uuid.Parse(dbMake.ID)
+	if err != nil {
+		return dbMake.Name, model
+	}
+	dbModel, err := s.store.ResolveCarModel(ctx, sqlc.ResolveCarModelParams{
+		MakeID: pgtype.UUID{Bytes: makeUUID, Valid: true},
+		Key:    catalogKey(model),
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			s.logger.Warn("failed to resolve car model", "make", dbMake.Name, "model", model, "error", err)
+		}
+		return dbMake.Name, model
+	}
+	return dbMake.Name, dbModel.Name
+}
+
+// resolveCatalogMake - admin so'rovidagi marka nomini (yoki aliasini) katalogdagi yozuvga keltirish
+func resolveCatalogMake(ctx context.Context, q *sqlc.Queries, name string) (sqlc.ResolveCarMakeRow, pgtype.UUID, error) {
+	dbMake, err := q.ResolveCarMake(ctx, catalogKey(name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbMake, pgtype.UUID{}, status.Error(codes.NotFound, "make not found in catalog")
+		}
+		return dbMake, pgtype.UUID{}, fmt.Errorf("resolve make: %w", err)
+	}
+	id, err := uuid.Parse(dbMake.ID)
+	if err != nil {
+		return dbMake, pgtype.UUID{}, fmt.Errorf("parse make id: %w", err)
+	}
+	return dbMake, pgtype.UUID{Bytes: id, Valid: true}, nil
+}
+
+// resolveCatalogModel - marka ichida model nomini (yoki aliasini) katalogdagi yozuvga keltirish
+func resolveCatalogModel(ctx context.Context, q *sqlc.Queries, makeID pgtype.UUID, name string) (sqlc.ResolveCarModelRow, pgtype.UUID, error) {
+	dbModel, err := q.ResolveCarModel(ctx, sqlc.ResolveCarModelParams{MakeID: makeID, Key: catalogKey(name)})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dbModel, pgtype.UUID{}, status.Error(codes.NotFound, "model not found in catalog")
+		}
+		return dbModel, pgtype.UUID{}, fmt.Errorf("resolve model: %w", err)
+	}
+	id, err := uuid.Parse(dbModel.ID)
+	if err != nil {
+		return dbModel, pgtype.UUID{}, fmt.Errorf("parse model id: %w", err)
+	}
+	return dbModel, pgtype.UUID{Bytes: id, Valid: true}, nil
+}
+
+// catalogAliasKeys - aliaslarni kalitga aylantirish, bo'sh va takrorlanganlarni tashlab yuborish
+func catalogAliasKeys(aliases []string, nameKey string) []string {
+	seen := map[string]bool{nameKey: true}
+	keys := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		key := catalogKey(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ---------------------- SEARCH ----------------------
+
+const (
+	maxSearchQueryLength = 100
+	// Shundan ko'p raqamli so'rovlar (telefon, hujjat raqami) jurnalga yozilmaydi
+	maxSearchQueryDigits = 6
+
+	defaultSuggestLimit  = 8
+	maxSuggestLimit      = 20
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 50
+)
+
+// anonymizeSearchQuery - so'rovni jurnal uchun normallashtirish. Shaxsiy ma'lumotga
+// o'xshagan (email, telefon raqami) so'rovlar uchun false qaytaradi.
+func anonymizeSearchQuery(query string) (string, bool) {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+	if query == "" || strings.Contains(query, "@") {
+		return "", false
+	}
+
+	digits := 0
+	for _, r := range query {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	if digits > maxSearchQueryDigits {
+		return "", false
+	}
+
+	if runes := []rune(query); len(runes) > maxSearchQueryLength {
+		query = string(runes[:maxSearchQueryLength])
+	}
+	return query, true
+}
+
+// logSearchQuery - qidiruv so'rovini anonim holda yozish (xatolar faqat log qilinadi)
+func (s *CarService) logSearchQuery(ctx context.Context, query string, results int) {
+	query, ok := anonymizeSearchQuery(query)
+	if !ok {
+		return
+	}
+	searcher := s.searcherKey(ctx)
+	if searcher == "" {
+		return
+	}
+	if err := s.store.LogSearchQuery(ctx, sqlc.LogSearchQueryParams{Query: query, Results: int32(results), Searcher: searcher}); err != nil {
+		s.logger.Warn("failed to log search query", "error", err)
+	}
+}
+
+// searcherKey - foydalanuvchi ID si (mehmon uchun IP) ning kalitli xeshi. Jurnalda asl qiymat
+// saqlanmaydi, faqat turli qidiruvchilar sanaladi. Aniqlab bo'lmasa bo'sh satr
+func (s *CarService) searcherKey(ctx context.Context) string {
+	id := ""
+	if userID, _ := requesterFromContext(ctx); userID != "" {
+		id = "user:" + userID
+	} else if ip := s.clientIPFromContext(ctx); ip != "" {
+		id = "ip:" + ip
+	} else {
+		return ""
+	}
+	mac := hmac.New(sha256.New, s.searcherSecret)
+	mac.Write([]byte(id))
+	return hex.EncodeToString(mac.Sum(nil))[:32]
+}
+
+// escapeLike - LIKE naqshidagi maxsus belgilarni (\, %, _) oddiy belgi sifatida ekranlash
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// clampLimit - limit berilmagan bo'lsa standart qiymat, katta bo'lsa maksimum
+func clampLimit(limit, def, max int32) int32 {
+	if limit <= 0 {
+		return def
+	}
+	if limit > max {
+		return max
+	}
+	return limit
+}
+
+// ---------------------- DESCRIPTIONS ----------------------
+
+// Tavsif tarjimalari qo'llab-quvvatlanadigan tillar
+var supportedLocales = map[string]bool{
+	"uz": true,
+	"ru": true,
+	"en": true,
+}
+
+// baseLocale - "ru-RU" -> "ru"
+func baseLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// preferredLocales - Accept-Language metadata sidan q qiymati bo'yicha saralangan qo'llab-quvvatlanadigan tillar
+func preferredLocales(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	header := md.Get("accept-language")
+	if len(header) == 0 {
+		return nil
+	}
+
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(strings.Join(header, ","), ",") {
+		fields := strings.Split(part, ";")
+		locale := baseLocale(fields[0])
+		if !supportedLocales[locale] {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			if v, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{locale: locale, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	seen := make(map[string]bool, len(tags))
+	locales := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if !seen[t.locale] {
+			seen[t.locale] = true
+			locales = append(locales, t.locale)
+		}
+	}
+	return locales
+}
+
+// validateDescriptions - tarjima kalitlarini tekshirib, asosiy til kodiga keltirish
+func validateDescriptions(descriptions map[string]string) (map[string]string, error) {
+	normalized := make(map[string]string, len(descriptions))
+	for tag, text := range descriptions {
+		locale := baseLocale(tag)
+		if !supportedLocales[locale] {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported description locale %q (uz, ru, en)", tag)
+		}
+		normalized[locale] = strings.TrimSpace(text)
+	}
+	return normalized, nil
+}
+
+// saveDescriptions - tarjimalarni saqlash, bo'sh matn shu tildagi tarjimani o'chiradi
+func saveDescriptions(ctx context.Context, q *sqlc.Queries, carID pgtype.UUID, descriptions map[string]string) error {
+	for locale, text := range descriptions {
+		if text == "" {
+			if err := q.DeleteCarDescription(ctx, sqlc.DeleteCarDescriptionParams{CarID: carID, Locale: locale}); err != nil {
+				return fmt.Errorf("delete description: %w", err)
+			}
+			continue
+		}
+		if err := q.UpsertCarDescription(ctx, sqlc.UpsertCarDescriptionParams{CarID: carID, Locale: locale, Description: text}); err != nil {
+			return fmt.Errorf("upsert description: %w", err)
+		}
+	}
+	return nil
+}
+
+// applyDescription - so'ralgan tillardan birinchi mavjud tarjimani qo'yish, bo'lmasa asl tavsif qoladi
+func applyDescription(car *pb.Car, locales []string, translations map[string]string) {
+	for _, locale := range locales {
+		if text, ok := translations[locale]; ok {
+			car.Description = text
+			car.DescriptionLocale = locale
+			return
+		}
+	}
+}
+
+// localizeCars - ro'yxatdagi mashinalar tavsifini Accept-Language bo'yicha tanlash (bitta so'rov bilan)
+func (s *CarService) localizeCars(ctx context.Context, cars []*pb.Car) {
+	locales := preferredLocales(ctx)
+	if len(locales) == 0 || len(cars) == 0 {
+		return
+	}
+
+	ids := make([]pgtype.UUID, 0, len(cars))
+	for _, car := range cars {
+		if id, err := uuid.Parse(car.Id); err == nil {
+			ids = append(ids, pgtype.UUID{Bytes: id, Valid: true})
+		}
+	}
+	rows, err := s.store.GetCarDescriptionsByCarIds(ctx, ids)
+	if err != nil {
+		s.logger.Warn("failed to load car descriptions", "error", err)
+		return
+	}
+
+	translations := make(map[string]map[string]string)
+	for _, row := range rows {
+		if translations[row.CarID] == nil {
+			translations[row.CarID] = make(map[string]string)
+		}
+		translations[row.CarID][row.Locale] = row.Description
+	}
+	for _, car := range cars {
+		applyDescription(car, locales, translations[car.Id])
+	}
+}
+
+// ---------------------- TRANSFERS ----------------------
+
+const (
+	transferPending   = "pending"
+	transferAccepted  = "accepted"
+	transferDeclined  = "declined"
+	transferCancelled = "cancelled"
+)
+
+var (
+	// errTransferNotPending - o'tkazish so'roviga allaqachon javob berilgan
+	errTransferNotPending = errors.New("transfer is not pending")
+	// errOwnerChanged - so'rov yaratilgandan keyin mashina egasi o'zgargan yoki mashina o'chirilgan
+	errOwnerChanged = errors.New("car owner changed or car deleted")
+)
+
+// notifyUser - bildirishnoma yaratish va push yuborish (xatolar faqat log qilinadi)
+func (s *CarService) notifyUser(ctx context.Context, userID, notifType, message string) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		s.logger.Warn("invalid notification recipient", "user_id", userID, "error", err)
+		return
+	}
+	if _, err := s.store.CreateNotification(ctx, sqlc.CreateNotificationParams{
+		UserID:  pgtype.UUID{Bytes: userUUID, Valid: true},
+		Type:    zero.StringFrom(notifType),
+		Message: zero.StringFrom(message),
+	}); err != nil {
+		s.logger.Warn("failed to create notification", "user_id", userID, "type", notifType, "error", err)
+		return
+	}
+	if err := s.sendPushNotification(ctx, userUUID, notifType, message); err != nil {
+		s.logger.Warn("failed to push notification", "user_id", userID, "type", notifType, "error", err)
+	}
+}
+
+// SQL natijasini protobuf formatiga o'tkazish
+func (s *CarService) convertDBCarTransferToProto(dbTransfer interface{}) *pb.CarTransfer {
+	var t sqlc.GetCarTransferRow
+
+	switch v := dbTransfer.(type) {
+	case sqlc.GetCarTransferRow:
+		t = v
+	case sqlc.CreateCarTransferRow:
+		t = sqlc.GetCarTransferRow(v)
+	case sqlc.ListCarTransfersByUserRow:
+		t = sqlc.GetCarTransferRow(v)
+	default:
+		s.logger.Error("unknown car transfer type", "type", fmt.Sprintf("%T", dbTransfer))
+		return &pb.CarTransfer{}
+	}
+
+	transfer := &pb.CarTransfer{
+		Id:         t.ID,
+		CarId:      t.CarID,
+		FromUserId: t.FromUserID,
+		ToUserId:   t.ToUserID,
+		Status:     t.Status,
+		CreatedAt:  t.CreatedAt.Time.Format(time.RFC3339),
+	}
+	if t.RespondedAt.Valid {
+		transfer.RespondedAt = t.RespondedAt.Time.Format(time.RFC3339)
+	}
+	return transfer
+}
+
+// ---------------------- ORGANIZATIONS ----------------------
+
+// Tashkilot a'zolari rollari
+const (
+	orgRoleOwner   = "owner"
+	orgRoleManager = "manager"
+	orgRoleAgent   = "agent"
+)
+
+var (
+	// errLastOrgOwner - tashkilotda kamida bitta owner qolishi kerak
+	errLastOrgOwner = errors.New("organization must keep at least one owner")
+	// errNotOrgMember - foydalanuvchi tashkilot a'zosi emas
+	errNotOrgMember = errors.New("user is not a member of the organization")
+)
+
+// Tashkilot e'loni ustidagi amallar
+const (
+	carActionEdit   = "edit"
+	carActionDelete = "delete"
+)
+
+// Tashkilot xabarlari sahifasi
+const (
+	defaultOrgMessagePageSize = 50
+	maxOrgMessagePageSize     = 200
+)
+
+func validOrgRole(role string) bool {
+	switch role {
+	case orgRoleOwner, orgRoleManager, orgRoleAgent:
+		return true
+	}
+	return false
+}
+
+// orgRoleAllows - owner va manager e'lonni tahrirlaydi va o'chiradi, agent faqat tahrirlaydi.
+// Xabarlarga barcha a'zolar javob bera oladi (CheckMessageOwnership da tekshiriladi)
+func orgRoleAllows(role, action string) bool {
+	switch role {
+	case orgRoleOwner, orgRoleManager:
+		return action == carActionEdit || action == carActionDelete
+	case orgRoleAgent:
+		return action == carActionEdit
+	}
+	return false
+}
+
+// orgMemberRole - foydalanuvchining tashkilotdagi roli (a'zo bo'lmasa PermissionDenied)
+func (s *CarService) orgMemberRole(ctx context.Context, orgID, userID pgtype.UUID) (string, error) {
+	role, err := s.store.GetOrganizationMemberRole(ctx, sqlc.GetOrganizationMemberRoleParams{
+		OrgID:  orgID,
+		UserID: userID,
+	})
+	if err != nil {
+		return "", status.Error(codes.PermissionDenied, "not a member of the organization")
+	}
+	return role, nil
+}
+
+// SQL natijasini protobuf formatiga o'tkazish
+func (s *CarService) convertDBOrganizationToProto(dbOrg interface{}) *pb.Organization {
+	var o sqlc.GetOrganizationRow
+
+	switch v := dbOrg.(type) {
+	case sqlc.GetOrganizationRow:
+		o = v
+	case sqlc.CreateOrganizationRow:
+		o = sqlc.GetOrganizationRow(v)
+	default:
+		s.logger.Error("unknown organization type", "type", fmt.Sprintf("%T", dbOrg))
+		return &pb.Organization{}
+	}
+
+	return &pb.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		CreatedBy: o.CreatedBy,
+		CreatedAt: o.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// ---------------------- QUOTAS ----------------------
+
+var (
+	// errListingQuota - rejadagi faol e'lonlar chegarasi to'lgan
+	errListingQuota = errors.New("active listing limit reached")
+	// errImageQuota - rejadagi bitta e'lon uchun rasmlar chegarasi to'lgan
+	errImageQuota = errors.New("image limit per car reached")
+	// errMediaQuota - bitta e'lon uchun video va hujjatlar chegarasi to'lgan
+	errMediaQuota = errors.New("media limit per car reached")
+)
+
+// enforceListingQuota - e'lon faol bo'lganda egasining reja chegarasiga sig'ishini tekshirish.
+// Tranzaksiya ichida chaqiriladi: lock tufayli bir foydalanuvchining parallel so'rovlari
+// navbat bilan sanaladi. carID ning o'zi hisobga olinmaydi, shuning uchun tekshiruv
+// yangi e'lon yozilgandan keyin ham bajarilishi mumkin
+func enforceListingQuota(ctx context.Context, q *sqlc.Queries, ownerID, carID pgtype.UUID) error {
+	if err := q.LockQuota(ctx, zero.StringFrom("listings:"+uuid.UUID(ownerID.Bytes).String())); err != nil {
+		return fmt.Errorf("lock listing quota: %w", err)
+	}
+	plan, err := q.GetUserPlan(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("get user plan: %w", err)
+	}
+	active, err := q.CountActiveListings(ctx, sqlc.CountActiveListingsParams{OwnerID: ownerID, ExcludeID: carID})
+	if err != nil {
+		return fmt.Errorf("count active listings: %w", err)
+	}
+	if active >= int64(plan.MaxActiveListings) {
+		return fmt.Errorf("%w: %d of %d on plan %q", errListingQuota, active, plan.MaxActiveListings, plan.Code)
+	}
+	return nil
+}
+
+// enforceImageQuota - e'longa yana bitta rasm qo'shish egasining rejasiga sig'ishini tekshirish
+func enforceImageQuota(ctx context.Context, q *sqlc.Queries, carID, ownerID pgtype.UUID) error {
+	if err := q.LockQuota(ctx, zero.StringFrom("images:"+uuid.UUID(carID.Bytes).String())); err != nil {
+		return fmt.Errorf("lock image quota: %w", err)
+	}
+	plan, err := q.GetUserPlan(ctx, ownerID)
+	if err != nil {
+		return fmt.Errorf("get user plan: %w", err)
+	}
+	images, err := q.CountCarImages(ctx, carID)
+	if err != nil {
+		return fmt.Errorf("count car images: %w", err)
+	}
+	if images >= int64(plan.MaxImagesPerCar) {
+		return fmt.Errorf("%w: %d of %d on plan %q", errImageQuota, images, plan.MaxImagesPerCar, plan.Code)
+	}
+	return nil
+}
+
+// enforceMediaQuota - e'longa yana bitta video yoki hujjat qo'shish mumkinmi (rejaga bog'liq emas)
+func enforceMediaQuota(ctx context.Context, q *sqlc.Queries, carID pgtype.UUID) error {
+	if err := q.LockQuota(ctx, zero.StringFrom("media:"+uuid.UUID(carID.Bytes).String())); err != nil {
+		return fmt.Errorf("lock media quota: %w", err)
+	}
+	count, err := q.CountCarMedia(ctx, carID)
+	if err != nil {
+		return fmt.Errorf("count car media: %w", err)
+	}
+	if count >= maxMediaPerCar {
+		return fmt.Errorf("%w: %d of %d", errMediaQuota, count, maxMediaPerCar)
+	}
+	return nil
+}
+
+// isQuotaError - xato reja chegarasi bilan bog'liqmi (ResourceExhausted sifatida qaytariladi)
+func isQuotaError(err error) bool {
+	return errors.Is(err, errListingQuota) || errors.Is(err, errImageQuota) || errors.Is(err, errMediaQuota)
+}
+
+// ---------------------- IMAGES ----------------------
+
+// errImageSetMismatch - tartib ro'yxati mashinaning joriy rasmlariga mos emas
+var errImageSetMismatch = errors.New("image_ids must list every image of the car exactly once")
+
+// phashMaxDistance - perseptual xeshlar shu masofagacha farq qilsa rasmlar deyarli bir xil hisoblanadi.
+// Bo'laklar bo'yicha qidiruv to'liq bo'lishi uchun 2*phashBands-1 dan oshmasligi kerak
+const phashMaxDistance = 6
+
+// phashBands - xesh shuncha 16 bitli bo'lakka bo'linadi (images.phash_b0..phash_b3)
+const phashBands = 4
+
+// phashBandCandidates - har bir bo'lak uchun o'zi va bitta biti o'zgargan 16 qiymat. Masofa
+// phashMaxDistance dan oshmasa kamida bitta bo'lak shu ro'yxatlardan biriga tushadi
+func phashBandCandidates(phash uint64) [phashBands][]int32 {
+	var bands [phashBands][]int32
+	for b := 0; b < phashBands; b++ {
+		v := int32((phash >> (16 * b)) & 0xffff)
+		bands[b] = make([]int32, 0, 17)
+		bands[b] = append(bands[b], v)
+		for bit := 0; bit < 16; bit++ {
+			bands[b] = append(bands[b], v^(1<<bit))
+		}
+	}
+	return bands
+}
+
+// flagDuplicateImage - boshqa egalarning e'lonlarida deyarli bir xil rasm bo'lsa mosliklarni
+// saqlaydi va e'lonni moderatsiya navbatiga qo'yadi (agar u allaqachon kutilmayotgan bo'lsa)
+func flagDuplicateImage(ctx context.Context, q *sqlc.Queries, image sqlc.AddImageRow, ownerID pgtype.UUID, phash int64) (bool, error) {
+	bands := phashBandCandidates(uint64(phash))
+	matches, err := q.FindSimilarImages(ctx, sqlc.FindSimilarImagesParams{
+		Phash:       pgtype.Int8{Int64: phash, Valid: true},
+		Band0:       bands[0],
+		Band1:       bands[1],
+		Band2:       bands[2],
+		Band3:       bands[3],
+		OwnerID:     ownerID,
+		MaxDistance: pgtype.Int4{Int32: phashMaxDistance, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("find similar images: %w", err)
+	}
+	if len(matches) == 0 {
+		return false, nil
+	}
+
+	imageUUID, err := uuid.Parse(image.ID)
+	if err != nil {
+		return false, fmt.Errorf("invalid image ID: %w", err)
+	}
+	imageID := pgtype.UUID{Bytes: imageUUID, Valid: true}
+	for _, m := range matches {
+		matchedUUID, err := uuid.Parse(m.ID)
+		if err != nil {
+			return false, fmt.Errorf("invalid matched image ID: %w", err)
+		}
+		if err := q.CreateImageMatch(ctx, sqlc.CreateImageMatchParams{
+			ImageID:        imageID,
+			MatchedImageID: pgtype.UUID{Bytes: matchedUUID, Valid: true},
+			Distance:       m.Distance,
+		}); err != nil {
+			return false, fmt.Errorf("create image match: %w", err)
+		}
+	}
+
+	carUUID, err := uuid.Parse(image.CarID)
+	if err != nil {
+		return false, fmt.Errorf("invalid car ID: %w", err)
+	}
+	if err := q.EnqueueModerationIfAbsent(ctx, sqlc.EnqueueModerationIfAbsentParams{
+		EntityType: entityCar,
+		EntityID:   pgtype.UUID{Bytes: carUUID, Valid: true},
+		OwnerID:    ownerID,
+	}); err != nil {
+		return false, fmt.Errorf("enqueue moderation: %w", err)
+	}
+	return true, nil
+}
+
+// SQL natijasini protobuf formatiga o'tkazish
+func (s *CarService) convertDBImageToProto(dbImage interface{}) *pb.Image {
+	var img sqlc.GetImageByIdRow
+
+	switch v := dbImage.(type) {
+	case sqlc.GetImageByIdRow:
+		img = v
+	case sqlc.AddImageRow:
+		img = sqlc.GetImageByIdRow(v)
+	case sqlc.GetImagesByCarRow:
+		img = sqlc.GetImageByIdRow(v)
+	default:
+		s.logger.Error("unknown image type", "type", fmt.Sprintf("%T", dbImage))
+		return &pb.Image{}
+	}
+
+	image := &pb.Image{
+		Id:         img.ID,
+		CarId:      img.CarID,
+		Filename:   img.Filename,
+		UploadedAt: img.UploadedAt.Time.Format(time.RFC3339),
+		Position:   img.Position,
+		IsCover:    img.IsCover,
+		Width:      img.Width.Int32,
+		Height:     img.Height.Int32,
+		SizeBytes:  img.SizeBytes.Int64,
+		Checksum:   img.Checksum.String,
+	}
+	s.setImageURLs(image, img.Variants)
+	return image
+}
+
+// setImageURLs - asl fayl va uning o'lchamlari uchun URL lar
+func (s *CarService) setImageURLs(image *pb.Image, variants []string) {
+	image.Url = s.fileURL(image.Filename)
+	if len(variants) == 0 {
+		return
+	}
+	image.Variants = make(map[string]string, len(variants))
+	for _, v := range variants {
+		image.Variants[v] = s.fileURL(blob.VariantKey(image.Filename, v))
+	}
+}
+
+// signedURL - kalit uchun signedURLTTL davomida amal qiladigan imzolangan URL
+func (s *CarService) signedURL(method, key string) (*pb.SignedURL, error) {
+	expiresAt := time.Now().Add(s.signedURLTTL)
+	url, err := s.signer.SignURL(method, key, s.signedURLTTL)
+	if err != nil {
+		s.logger.Error("failed to sign URL", "key", key, "error", err)
+		return nil, status.Error(codes.Internal, "failed to sign URL")
+	}
+	return &pb.SignedURL{
+		Url:       url,
+		Method:    method,
+		Key:       key,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// fileURL - blob kalitidan ochiq URL. Eski yozuvlarda filename to'liq URL bo'lishi mumkin
+func (s *CarService) fileURL(key string) string {
+	if key == "" || strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://") {
+		return key
+	}
+	return strings.TrimRight(s.filesBaseURL, "/") + "/" + key
+}
+
+// ---------------------- MEDIA ----------------------
+
+const (
+	mediaImage    = "image"
+	mediaVideo    = "video"
+	mediaDocument = "document"
+
+	visibilityPublic  = "public"
+	visibilityPrivate = "private"
+
+	// maxMediaPerCar - bitta e'londagi video va hujjatlar soni (rasmlar reja bo'yicha alohida sanaladi)
+	maxMediaPerCar = 20
+)
+
+// mediaKeyPrefix - upload handler media faylini saqlaydigan prefiks. Yopiq fayllar
+// private/ ostida bo'lishi shart, aks holda ular ochiq URL orqali beriladi
+func mediaKeyPrefix(carID, visibility string) string {
+	prefix := "cars/" + carID + "/"
+	if visibility == visibilityPrivate {
+		return blob.PrivatePrefix + prefix
+	}
+	return prefix
+}
+
+// mediaSnapshot - URL larsiz media elementi (audit jurnaliga imzolangan URL yozilmaydi)
+func mediaSnapshot(item sqlc.ListCarMediaRow) *pb.MediaItem {
+	return &pb.MediaItem{
+		Id:         item.ID,
+		CarId:      item.CarID,
+		Kind:       item.Kind,
+		MimeType:   item.MimeType.String,
+		Visibility: item.Visibility,
+		Position:   item.Position,
+		SizeBytes:  item.SizeBytes.Int64,
+		Checksum:   item.Checksum.String,
+		UploadedAt: item.UploadedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// convertMediaToProto - yopiq elementlar uchun URL imzolanadi va muddati cheklanadi
+func (s *CarService) convertMediaToProto(item sqlc.ListCarMediaRow) (*pb.MediaItem, error) {
+	media := mediaSnapshot(item)
+	if item.Visibility != visibilityPrivate {
+		media.Url = s.fileURL(item.Filename)
+		if len(item.Variants) > 0 {
+			media.Variants = make(map[string]string, len(item.Variants))
+			for _, v := range item.Variants {
+				media.Variants[v] = s.fileURL(blob.VariantKey(item.Filename, v))
+			}
+		}
+		return media, nil
+	}
+	if s.signer == nil {
+		return nil, status.Error(codes.Unavailable, "signed downloads are not configured")
+	}
+	signed, err := s.signedURL(http.MethodGet, item.Filename)
+	if err != nil {
+		return nil, err
+	}
+	media.Url = signed.Url
+	return media, nil
+}
+
+// ---------------------- COMMENTS ----------------------
+
+// threadComments - yaratilish vaqti bo'yicha tartiblangan kommentariyalarni daraxt tartibida
+// tekislaydi: har bir javob otasidan keyin, bir xil ota javoblari vaqt bo'yicha. Otasi ko'rinmaydigan
+// (moderatsiyada yoki rad etilgan) javoblar ham ko'rsatilmaydi
+func threadComments(rows []sqlc.GetCommentRepliesRow) []*pb.Comment {
+	children := make(map[string][]*pb.Comment, len(rows))
+	for _, row := range rows {
+		comment := &pb.Comment{
+			Id:        row.ID,
+			UserId:    row.UserID,
+			CarId:     row.CarID,
+			Content:   row.Content,
+			CreatedAt: row.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt: row.UpdatedAt.Time.Format(time.RFC3339),
+			Depth:     row.Depth,
+			IsSeller:  row.IsSeller,
+			LikeCount: row.LikeCount,
+		}
+		if row.ParentID.Valid {
+			comment.ParentId = uuid.UUID(row.ParentID.Bytes).String()
+		}
+		children[comment.ParentId] = append(children[comment.ParentId], comment)
+	}
+
+	comments := make([]*pb.Comment, 0, len(rows))
+	var walk func(parentID string)
+	walk = func(parentID string) {
+		for _, c := range children[parentID] {
+			comments = append(comments, c)
+			walk(c.Id)
+		}
+	}
+	walk("")
+	return comments
+}
+
+// Kommentariyalarni saralash turlari
+const (
+	commentSortNewest    = "newest"
+	commentSortOldest    = "oldest"
+	commentSortMostLiked = "most_liked"
+
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 100
+	// maxRepliesPerComment - bitta ildiz ostidagi butun shoxdan qaytariladigan eng eski javoblar soni
+	maxRepliesPerComment = 100
+)
+
+// commentCursor - sahifadagi oxirgi ildiz kommentariyaning saralash kaliti.
+// Mijozga base64 qilingan JSON ko'rinishida beriladi va uning ichiga qaralmaydi
+type commentCursor struct {
+	Sort      string    `json:"s"`
+	ID        uuid.UUID `json:"i"`
+	CreatedAt time.Time `json:"t"`
+	Likes     int32     `json:"l,omitempty"`
+}
+
+func encodeCommentCursor(c commentCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCommentCursor - kursor boshqa saralash uchun berilgan bo'lsa rad etiladi
+func decodeCommentCursor(raw, sortBy string) (commentCursor, error) {
+	var c commentCursor
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil || json.Unmarshal(b, &c) != nil || c.ID == uuid.Nil {
+		return c, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	if c.Sort != sortBy {
+		return c, status.Error(codes.InvalidArgument, "cursor was issued for a different sort order")
+	}
+	return c, nil
+}
+
+// rootComments - saralash turiga mos so'rov bilan ildiz kommentariyalar sahifasi. Har bir saralash
+// o'z indeksini ORDER BY bo'yicha o'qishi uchun so'rovlar alohida
+func (s *CarService) rootComments(ctx context.Context, carID pgtype.UUID, sortBy string, cursor *commentCursor, limit int32) ([]sqlc.GetCommentRepliesRow, error) {
+	var (
+		cursorID        pgtype.UUID
+		cursorCreatedAt pgtype.Timestamptz
+		cursorLikes     pgtype.Int4
+	)
+	if cursor != nil {
+		cursorID = pgtype.UUID{Bytes: cursor.ID, Valid: true}
+		cursorCreatedAt = pgtype.Timestamptz{Time: cursor.CreatedAt, Valid: true}
+		cursorLikes = pgtype.Int4{Int32: cursor.Likes, Valid: true}
+	}
+	pageLimit := pgtype.Int4{Int32: limit, Valid: true}
+
+	var roots []sqlc.GetCommentRepliesRow
+	switch sortBy {
+	case commentSortOldest:
+		rows, err := s.store.GetCommentsByCarOldest(ctx, sqlc.GetCommentsByCarOldestParams{
+			CarID: carID, CursorID: cursorID, CursorCreatedAt: cursorCreatedAt, Limit: pageLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			roots = append(roots, sqlc.GetCommentRepliesRow(r))
+		}
+	case commentSortMostLiked:
+		rows, err := s.store.GetCommentsByCarMostLiked(ctx, sqlc.GetCommentsByCarMostLikedParams{
+			CarID: carID, CursorID: cursorID, CursorLikes: cursorLikes, CursorCreatedAt: cursorCreatedAt, Limit: pageLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			roots = append(roots, sqlc.GetCommentRepliesRow(r))
+		}
+	default:
+		rows, err := s.store.GetCommentsByCarNewest(ctx, sqlc.GetCommentsByCarNewestParams{
+			CarID: carID, CursorID: cursorID, CursorCreatedAt: cursorCreatedAt, Limit: pageLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rows {
+			roots = append(roots, sqlc.GetCommentRepliesRow(r))
+		}
+	}
+	return roots, nil
+}
+
+// commentReactionTypes - ruxsat etilgan reaksiyalar. like_count faqat "like" larni sanaydi
+var commentReactionTypes = map[string]bool{
+	"like":    true,
+	"helpful": true,
+}
+
+// reactionTarget - reaksiya qo'yiladigan kommentariya e'lon qilingan bo'lishi kerak
+func (s *CarService) reactionTarget(ctx context.Context, id string) (pgtype.UUID, pgtype.UUID, error) {
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, err
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	commentUUID, err := uuid.Parse(id)
+	if err != nil {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Error(codes.InvalidArgument, "invalid comment ID format")
+	}
+	commentID := pgtype.UUID{Bytes: commentUUID, Valid: true}
+
+	info, err := s.store.GetCommentThreadInfo(ctx, commentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Error(codes.NotFound, "comment not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get comment", "error", err)
+		return pgtype.UUID{}, pgtype.UUID{}, status.Error(codes.Internal, "failed to retrieve comment")
+	}
+	if info.ModerationStatus != moderationApproved {
+		return pgtype.UUID{}, pgtype.UUID{}, status.Error(codes.FailedPrecondition, "comment is not published")
+	}
+	return commentID, pgtype.UUID{Bytes: userUUID, Valid: true}, nil
+}
+
+// lockCommentReactions - bitta kommentariya layklarini parallel tranzaksiyalar ketma-ket qayta hisoblashi uchun
+func lockCommentReactions(ctx context.Context, q *sqlc.Queries, commentID pgtype.UUID) error {
+	return q.LockQuota(ctx, zero.StringFrom("reactions:"+uuid.UUID(commentID.Bytes).String()))
+}
+
+// commentReactions - bitta kommentariyaning reaksiyalar soni va chaqiruvchining reaksiyasi
+func (s *CarService) commentReactions(ctx context.Context, commentID pgtype.UUID, mine string) (*pb.CommentReactions, error) {
+	rows, err := s.store.GetCommentReactionCounts(ctx, []pgtype.UUID{commentID})
+	if err != nil {
+		s.logger.Error("failed to get comment reactions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to retrieve reactions")
+	}
+	res := &pb.CommentReactions{
+		CommentId:  uuid.UUID(commentID.Bytes).String(),
+		Reactions:  make(map[string]int32, len(rows)),
+		MyReaction: mine,
+	}
+	for _, r := range rows {
+		res.Reactions[r.Reaction] = int32(r.Count)
+	}
+	res.LikeCount = res.Reactions["like"]
+	return res, nil
+}
+
+// attachReactions - kommentariyalarga reaksiyalar sonini va (token bo'lsa) ko'ruvchining reaksiyasini qo'shadi
+func (s *CarService) attachReactions(ctx context.Context, comments []*pb.Comment, viewer pgtype.UUID) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	ids := make([]pgtype.UUID, 0, len(comments))
+	byID := make(map[string]*pb.Comment, len(comments))
+	for _, c := range comments {
+		commentUUID, err := uuid.Parse(c.Id)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, pgtype.UUID{Bytes: commentUUID, Valid: true})
+		byID[c.Id] = c
+	}
+
+	counts, err := s.store.GetCommentReactionCounts(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, r := range counts {
+		c := byID[r.CommentID]
+		if c.Reactions == nil {
+			c.Reactions = make(map[string]int32)
+		}
+		c.Reactions[r.Reaction] = int32(r.Count)
+	}
+
+	if !viewer.Valid {
+		return nil
+	}
+	mine, err := s.store.GetUserCommentReactions(ctx, sqlc.GetUserCommentReactionsParams{
+		UserID:     viewer,
+		CommentIds: ids,
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range mine {
+		byID[r.CommentID].MyReaction = r.Reaction
+	}
+	return nil
+}
+
+// ---------------------- REVIEWS ----------------------
+
+const (
+	reviewTargetCar    = "car"
+	reviewTargetSeller = "seller"
+
+	// maxReviewLength - baho matnining maksimal uzunligi (belgilar)
+	maxReviewLength = 2000
+
+	defaultReviewPageSize = 20
+	maxReviewPageSize     = 100
+)
+
+// validateReview - baho 1..5 oralig'ida, matn bo'sh joylarsiz va chegaradan oshmasligi kerak
+func validateReview(rating int32, content string) (string, error) {
+	if rating < 1 || rating > 5 {
+		return "", status.Error(codes.InvalidArgument, "rating must be between 1 and 5")
+	}
+	content = strings.TrimSpace(content)
+	if utf8.RuneCountInString(content) > maxReviewLength {
+		return "", status.Error(codes.InvalidArgument, fmt.Sprintf("review must be at most %d characters", maxReviewLength))
+	}
+	return content, nil
+}
+
+// reviewTarget - baho obyekti va u tegishli sotuvchini aniqlaydi. E'lon uchun sotuvchi - uning egasi
+func (s *CarService) reviewTarget(ctx context.Context, targetType, targetID string) (uuid.UUID, uuid.UUID, error) {
+	targetUUID, err := uuid.Parse(targetID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid target ID format")
+	}
+
+	switch targetType {
+	case reviewTargetSeller:
+		return targetUUID, targetUUID, nil
+	case reviewTargetCar:
+		car, err := s.store.GetCarById(ctx, pgtype.UUID{Bytes: targetUUID, Valid: true})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, uuid.Nil, status.Error(codes.NotFound, "car not found")
+		}
+		if err != nil {
+			s.logger.Error("failed to get car for review", "error", err)
+			return uuid.Nil, uuid.Nil, status.Error(codes.Internal, "failed to retrieve car")
+		}
+		if car.ModerationStatus != moderationApproved && !canViewUnmoderated(ctx, car.OwnerID) {
+			return uuid.Nil, uuid.Nil, status.Error(codes.NotFound, "car not found")
+		}
+		ownerUUID, err := uuid.Parse(car.OwnerID)
+		if err != nil {
+			return uuid.Nil, uuid.Nil, status.Error(codes.Internal, "invalid car owner")
+		}
+		return targetUUID, ownerUUID, nil
+	default:
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "target_type must be car or seller")
+	}
+}
+
+// getOwnReview - bahoni oladi va joriy foydalanuvchi uning muallifi ekanligini tekshiradi.
+// allowAdmin bo'lsa admin ham o'tadi
+func (s *CarService) getOwnReview(ctx context.Context, id string, allowAdmin bool) (sqlc.GetReviewByIdRow, error) {
+	userID, err := s.getUserIDFromContext(ctx)
+	if err != nil {
+		return sqlc.GetReviewByIdRow{}, err
+	}
+	reviewUUID, err := uuid.Parse(id)
+	if err != nil {
+		return sqlc.GetReviewByIdRow{}, status.Error(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	review, err := s.store.GetReviewById(ctx, pgtype.UUID{Bytes: reviewUUID, Valid: true})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return review, status.Error(codes.NotFound, "review not found")
+	}
+	if err != nil {
+		s.logger.Error("failed to get review", "error", err)
+		return review, status.Error(codes.Internal, "failed to retrieve review")
+	}
+	if review.ReviewerID != userID && !(allowAdmin && s.isAdmin(ctx)) {
+		return review, status.Error(codes.PermissionDenied, "you are not the author of this review")
+	}
+	return review, nil
+}
+
+// lockReviewTarget - bitta obyekt agregatini parallel tranzaksiyalar ketma-ket qayta hisoblashi uchun
+func lockReviewTarget(ctx context.Context, q *sqlc.Queries, targetType string, targetID pgtype.UUID) error {
+	return q.LockQuota(ctx, zero.StringFrom("reviews:"+targetType+":"+uuid.UUID(targetID.Bytes).String()))
+}
+
+// refreshRating - obyektning baholar yig'indisi va sonini reviews jadvalidan qayta hisoblaydi
+func refreshRating(ctx context.Context, q *sqlc.Queries, targetType string, targetID pgtype.UUID) error {
+	if targetType == reviewTargetCar {
+		return q.RefreshCarRating(ctx, targetID)
+	}
+	return q.RefreshSellerRating(ctx, targetID)
+}
+
+// refreshReviewRating - sharhning moderatsiya holati o'zgarganda u tegishli agregatni qayta hisoblash
+// (reytingga faqat tasdiqlangan sharhlar kiradi)
+func refreshReviewRating(ctx context.Context, q *sqlc.Queries, id pgtype.UUID) error {
+	review, err := q.GetReviewById(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get review: %w", err)
+	}
+	targetUUID, err := uuid.Parse(review.TargetID)
+	if err != nil {
+		return fmt.Errorf("invalid review target: %w", err)
+	}
+	targetID := pgtype.UUID{Bytes: targetUUID, Valid: true}
+	if err := lockReviewTarget(ctx, q, review.TargetType, targetID); err != nil {
+		return err
+	}
+	return refreshRating(ctx, q, review.TargetType, targetID)
+}
+
+// ratingAverage - o'rtacha baho ikki kasr aniqligida, baho bo'lmasa 0
+func ratingAverage(sum, count int32) float64 {
+	if count == 0 {
+		return 0
+	}
+	return math.Round(float64(sum)/float64(count)*100) / 100
+}
+
+func convertReviewToProto(r sqlc.GetReviewByIdRow) *pb.Review {
+	return &pb.Review{
+		Id:         r.ID,
+		ReviewerId: r.ReviewerID,
+		TargetType: r.TargetType,
+		TargetId:   r.TargetID,
+		SellerId:   r.SellerID,
+		Rating:     int32(r.Rating),
+		Content:    r.Content,
+		CreatedAt:  r.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  r.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
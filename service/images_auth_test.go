@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+	"wegugin/config"
+	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
+	"wegugin/storage/postgres"
+	"wegugin/storage/postgres/sqlc"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// imageAuthStore - rasm RPC lari tegishlik tekshiruvida ishlatadigan so'rovlarni almashtiruvchi
+// soxta store. Qolgan metodlar chaqirilsa nil interfeys tufayli panic bo'ladi
+type imageAuthStore struct {
+	postgres.Store
+
+	carID   string
+	imageID string
+	ownerID string
+	members map[string]string // user_id -> tashkilotdagi rol
+
+	calls []string // bajarilgan so'rovlar nomi (tranzaksiya ichidagilar ham)
+}
+
+func (st *imageAuthStore) CheckCarOwnership(ctx context.Context, arg sqlc.CheckCarOwnershipParams) (bool, error) {
+	return uuid.UUID(arg.ID.Bytes).String() == st.carID && uuid.UUID(arg.OwnerID.Bytes).String() == st.ownerID, nil
+}
+
+func (st *imageAuthStore) CheckImageOwnership(ctx context.Context, arg sqlc.CheckImageOwnershipParams) (bool, error) {
+	return uuid.UUID(arg.ID.Bytes).String() == st.imageID && uuid.UUID(arg.OwnerID.Bytes).String() == st.ownerID, nil
+}
+
+func (st *imageAuthStore) GetCarMemberRole(ctx context.Context, arg sqlc.GetCarMemberRoleParams) (string, error) {
+	role, ok := st.members[uuid.UUID(arg.UserID.Bytes).String()]
+	if !ok || uuid.UUID(arg.CarID.Bytes).String() != st.carID {
+		return "", pgx.ErrNoRows
+	}
+	return role, nil
+}
+
+func (st *imageAuthStore) GetImageById(ctx context.Context, id pgtype.UUID) (sqlc.GetImageByIdRow, error) {
+	return sqlc.GetImageByIdRow{ID: st.imageID, CarID: st.carID, Filename: "cars/" + st.carID + "/a.jpg"}, nil
+}
+
+func (st *imageAuthStore) GetCarById(ctx context.Context, id pgtype.UUID) (sqlc.GetCarByIdRow, error) {
+	return sqlc.GetCarByIdRow{ID: st.carID, OwnerID: st.ownerID}, nil
+}
+
+func (st *imageAuthStore) GetImagesByCar(ctx context.Context, carID pgtype.UUID) ([]sqlc.GetImagesByCarRow, error) {
+	return nil, nil
+}
+
+func (st *imageAuthStore) DeleteImagesByCarId(ctx context.Context, carID pgtype.UUID) error {
+	st.calls = append(st.calls, "DeleteImagesByCarId")
+	return nil
+}
+
+func (st *imageAuthStore) GetMediaById(ctx context.Context, id pgtype.UUID) (sqlc.GetMediaByIdRow, error) {
+	return sqlc.GetMediaByIdRow{ID: uuid.UUID(id.Bytes).String(), CarID: st.carID, Kind: mediaVideo}, nil
+}
+
+// ExecTx - tranzaksiya tanasini fakeDBTX ustidagi sqlc.Queries bilan bajaradi
+func (st *imageAuthStore) ExecTx(ctx context.Context, fn func(*sqlc.Queries) error) error {
+	return fn(sqlc.New(&fakeDBTX{store: st}))
+}
+
+// fakeDBTX - so'rovlar nomini yozib boradigan va har bir qatorni soxta qiymatlar bilan
+// to'ldiradigan sqlc.DBTX: matn ustunlari mashina ID si, rejadagi chegaralar 10, sonlar 0
+type fakeDBTX struct {
+	store *imageAuthStore
+}
+
+func (db *fakeDBTX) record(sql string) {
+	name, _, _ := strings.Cut(strings.TrimPrefix(sql, "-- name: "), " ")
+	db.store.calls = append(db.store.calls, name)
+}
+
+func (db *fakeDBTX) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	db.record(sql)
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (db *fakeDBTX) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	db.record(sql)
+	return &emptyRows{}, nil
+}
+
+func (db *fakeDBTX) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	db.record(sql)
+	return fakeRow{carID: db.store.carID}
+}
+
+// emptyRows - bo'sh natija (masalan, mashinada rasm yo'q)
+type emptyRows struct{}
+
+func (*emptyRows) Close()                                       {}
+func (*emptyRows) Err() error                                   { return nil }
+func (*emptyRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("SELECT 0") }
+func (*emptyRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (*emptyRows) Next() bool                                   { return false }
+func (*emptyRows) Scan(dest ...any) error                       { return pgx.ErrNoRows }
+func (*emptyRows) Values() ([]any, error)                       { return nil, nil }
+func (*emptyRows) RawValues() [][]byte                          { return nil }
+func (*emptyRows) Conn() *pgx.Conn                              { return nil }
+
+type fakeRow struct {
+	carID string
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	for _, d := range dest {
+		switch d := d.(type) {
+		case *string:
+			*d = r.carID
+		case *int32:
+			*d = 10
+		}
+	}
+	return nil
+}
+
+func (st *imageAuthStore) CreateAuditLog(ctx context.Context, arg sqlc.CreateAuditLogParams) error {
+	return nil
+}
+
+// tokenContext - foydalanuvchi tokeni bilan kiruvchi gRPC konteksti
+func tokenContext(t *testing.T, userID, role string) context.Context {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID,
+		"role":    role,
+	}).SignedString([]byte(config.Load().Token.TOKEN_KEY))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestImageMutationsRequireCarAccess(t *testing.T) {
+	var (
+		carID    = uuid.NewString()
+		imageID  = uuid.NewString()
+		ownerID  = uuid.NewString()
+		editorID = uuid.NewString()
+		otherID  = uuid.NewString()
+		adminID  = uuid.NewString()
+	)
+
+	// want - ruxsat berilganda bajarilishi kerak bo'lgan so'rov ("" - store ga yozmaydi)
+	rpcs := map[string]struct {
+		call func(s *CarService, ctx context.Context) error
+		want string
+	}{
+		"AddImage": {func(s *CarService, ctx context.Context) error {
+			_, err := s.AddImage(ctx, &pb.AddImageRequest{CarId: carID, Filename: "cars/" + carID + "/a.jpg"})
+			return err
+		}, "AddImage"},
+		"DeleteImage": {func(s *CarService, ctx context.Context) error {
+			_, err := s.DeleteImage(ctx, &pb.ImageId{Id: imageID})
+			return err
+		}, "DeleteImage"},
+		"RestoreImage": {func(s *CarService, ctx context.Context) error {
+			_, err := s.RestoreImage(ctx, &pb.ImageId{Id: imageID})
+			return err
+		}, "RestoreImage"},
+		"DeleteImagesByCarId": {func(s *CarService, ctx context.Context) error {
+			_, err := s.DeleteImagesByCarId(ctx, &pb.CarId{CarId: carID})
+			return err
+		}, "DeleteImagesByCarId"},
+		"ReorderCarImages": {func(s *CarService, ctx context.Context) error {
+			_, err := s.ReorderCarImages(ctx, &pb.ReorderCarImagesRequest{CarId: carID})
+			return err
+		}, "SetImagePositions"},
+		"CreateImageUploadURL": {func(s *CarService, ctx context.Context) error {
+			_, err := s.CreateImageUploadURL(ctx, &pb.CreateImageUploadURLRequest{CarId: carID})
+			return err
+		}, "CountCarImages"},
+		"CreateImageDownloadURL": {func(s *CarService, ctx context.Context) error {
+			_, err := s.CreateImageDownloadURL(ctx, &pb.ImageId{Id: imageID})
+			return err
+		}, ""},
+		// ConfirmUpload va multipart yuklashlar faylni o'qishdan oldin shu RPC ni chaqiradi
+		"AuthorizeCarUpload": {func(s *CarService, ctx context.Context) error {
+			_, err := s.AuthorizeCarUpload(ctx, &pb.CarId{CarId: carID})
+			return err
+		}, ""},
+		"AddCarMedia": {func(s *CarService, ctx context.Context) error {
+			_, err := s.AddCarMedia(ctx, &pb.AddCarMediaRequest{
+				CarId:      carID,
+				Filename:   mediaKeyPrefix(carID, visibilityPrivate) + "doc.pdf",
+				Kind:       mediaDocument,
+				MimeType:   "application/pdf",
+				Visibility: visibilityPrivate,
+			})
+			return err
+		}, "AddMedia"},
+		"DeleteCarMedia": {func(s *CarService, ctx context.Context) error {
+			_, err := s.DeleteCarMedia(ctx, &pb.MediaId{Id: uuid.NewString()})
+			return err
+		}, "DeleteImage"},
+	}
+
+	callers := []struct {
+		name    string
+		userID  string
+		role    string
+		allowed bool
+	}{
+		{"non-owner", otherID, "user", false},
+		{"owner", ownerID, "user", true},
+		{"org editor", editorID, "user", true},
+		{"admin override", adminID, roleAdmin, true},
+	}
+
+	for rpcName, rpc := range rpcs {
+		for _, c := range callers {
+			t.Run(rpcName+"/"+c.name, func(t *testing.T) {
+				store := &imageAuthStore{
+					carID:   carID,
+					imageID: imageID,
+					ownerID: ownerID,
+					members: map[string]string{editorID: orgRoleAgent},
+				}
+				s := &CarService{
+					store:        store,
+					logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+					signer:       blob.NewHMACSigner("http://localhost:8080/v1/files", "test-secret"),
+					signedURLTTL: time.Minute,
+				}
+
+				err := rpc.call(s, tokenContext(t, c.userID, c.role))
+				if !c.allowed {
+					if status.Code(err) != codes.PermissionDenied {
+						t.Fatalf("expected PermissionDenied, got %v", err)
+					}
+					if len(store.calls) != 0 {
+						t.Fatalf("expected no store calls, got %v", store.calls)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				if rpc.want != "" && !slices.Contains(store.calls, rpc.want) {
+					t.Fatalf("expected %s to reach the store, got %v", rpc.want, store.calls)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"wegugin/config"
+)
+
+// BlobStore - yuklangan fayllar (rasmlar va h.k.) uchun almashtiriladigan saqlash qatlami
+type BlobStore interface {
+	// Put - r dagi baytlarni key ostida saqlaydi (mavjud obyekt ustidan yoziladi)
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get - obyektni o'qish uchun ochadi, topilmasa ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Stat - obyekt hajmi va o'zgartirilgan vaqti (tanani o'qimasdan), topilmasa ErrNotFound
+	Stat(ctx context.Context, key string) (Object, error)
+	// Delete - obyektni o'chiradi, mavjud bo'lmasa xato qaytarmaydi
+	Delete(ctx context.Context, key string) error
+	// List - prefix bilan boshlanuvchi obyektlarni kalit bo'yicha o'sish tartibida fn ga beradi.
+	// fn xato qaytarsa, ro'yxatlash to'xtaydi va o'sha xato qaytariladi
+	List(ctx context.Context, prefix string, fn func(Object) error) error
+}
+
+// Object - List natijasidagi obyekt
+type Object struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// ErrNotFound - berilgan kalit bo'yicha obyekt yo'q
+var ErrNotFound = errors.New("blob not found")
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// New - konfiguratsiyadagi BLOB_DRIVER bo'yicha saqlash qatlamini yaratadi
+func New(ctx context.Context, cfg config.BlobConfig) (BlobStore, error) {
+	switch cfg.BLOB_DRIVER {
+	case DriverLocal:
+		return NewLocalStore(cfg.BLOB_LOCAL_DIR)
+	case DriverS3:
+		return NewS3Store(ctx, S3Options{
+			Endpoint:  cfg.S3_ENDPOINT,
+			Region:    cfg.S3_REGION,
+			Bucket:    cfg.S3_BUCKET,
+			AccessKey: cfg.S3_ACCESS_KEY,
+			SecretKey: cfg.S3_SECRET_KEY,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", cfg.BLOB_DRIVER)
+	}
+}
+
+// validKey - kalit nisbiy, bo'sh bo'lmagan va ".." siz bo'lishi kerak
+func validKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return fmt.Errorf("invalid blob key %q", key)
+	}
+	return nil
+}
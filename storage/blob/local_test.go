@@ -0,0 +1,161 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		data string
+	}{
+		{"public image", "cars/1/a.jpg", "jpeg bytes"},
+		{"variant", "cars/1/a_thumb.jpg", "thumb bytes"},
+		{"private document", "private/cars/1/doc.pdf", "%PDF"},
+		{"overwrite", "cars/1/a.jpg", "new jpeg bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.Put(ctx, tt.key, strings.NewReader(tt.data), "application/octet-stream"); err != nil {
+				t.Fatalf("Put: %v", err)
+			}
+			rc, err := s.Get(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			got, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.data {
+				t.Fatalf("Get = %q, want %q", got, tt.data)
+			}
+		})
+	}
+
+	t.Run("list skips temp files and filters by prefix", func(t *testing.T) {
+		if err := os.WriteFile(filepath.Join(s.root, "cars", "1", ".upload-123"), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		err := s.List(ctx, "cars/", func(o Object) error {
+			keys = append(keys, o.Key)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		want := []string{"cars/1/a.jpg", "cars/1/a_thumb.jpg"}
+		if !reflect.DeepEqual(keys, want) {
+			t.Fatalf("List = %v, want %v", keys, want)
+		}
+	})
+
+	t.Run("stat", func(t *testing.T) {
+		obj, err := s.Stat(ctx, "cars/1/a.jpg")
+		if err != nil {
+			t.Fatalf("Stat: %v", err)
+		}
+		if obj.Key != "cars/1/a.jpg" || obj.Size != int64(len("new jpeg bytes")) {
+			t.Fatalf("Stat = %+v", obj)
+		}
+		if _, err := s.Stat(ctx, "cars/1/missing.jpg"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Stat missing = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("delete is idempotent", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			if err := s.Delete(ctx, "cars/1/a.jpg"); err != nil {
+				t.Fatalf("Delete #%d: %v", i+1, err)
+			}
+		}
+		if _, err := s.Get(ctx, "cars/1/a.jpg"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Get after delete = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("rejects keys outside the root", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "../secret", "cars/../../secret", ".."} {
+			if err := s.Put(ctx, key, strings.NewReader("x"), ""); err == nil {
+				t.Errorf("Put(%q) succeeded, want error", key)
+			}
+			if _, err := s.Get(ctx, key); err == nil {
+				t.Errorf("Get(%q) succeeded, want error", key)
+			}
+		}
+	})
+}
+
+func TestHMACSigner(t *testing.T) {
+	signer := NewHMACSigner("http://localhost:8080/v1/files/", "test-secret")
+	key := "private/cars/1/doc.pdf"
+
+	signed, err := signer.SignURL(http.MethodGet, key, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Path != "/v1/files/"+key {
+		t.Fatalf("path = %q", u.Path)
+	}
+	valid := u.Query()
+
+	expired := url.Values{}
+	past := time.Now().Add(-time.Minute).Unix()
+	expired.Set("expires", strconv.FormatInt(past, 10))
+	expired.Set("signature", signer.mac(http.MethodGet, key, past))
+
+	tampered := url.Values{}
+	tampered.Set("expires", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	tampered.Set("signature", valid.Get("signature"))
+
+	tests := []struct {
+		name   string
+		method string
+		key    string
+		query  url.Values
+		want   error
+	}{
+		{"valid", http.MethodGet, key, valid, nil},
+		{"other method", http.MethodPut, key, valid, ErrInvalidSignature},
+		{"other key", http.MethodGet, "private/cars/1/other.pdf", valid, ErrInvalidSignature},
+		{"extended expiry", http.MethodGet, key, tampered, ErrInvalidSignature},
+		{"expired", http.MethodGet, key, expired, ErrURLExpired},
+		{"missing", http.MethodGet, key, url.Values{}, ErrInvalidSignature},
+		{"other secret", http.MethodGet, key, func() url.Values {
+			other, _ := NewHMACSigner("http://localhost:8080/v1/files", "other-secret").SignURL(http.MethodGet, key, time.Minute)
+			u, _ := url.Parse(other)
+			return u.Query()
+		}(), ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := signer.Verify(tt.method, tt.key, tt.query); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
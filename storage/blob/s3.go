@@ -0,0 +1,414 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Options - S3 bilan mos saqlash (AWS S3, MinIO va h.k.) sozlamalari
+type S3Options struct {
+	Endpoint  string // masalan http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store - obyektlarni S3 API orqali path-style URL bilan saqlaydi.
+// So'rovlar AWS Signature V4 bilan imzolanadi
+type S3Store struct {
+	endpoint *url.URL
+	opts     S3Options
+	client   *http.Client
+}
+
+const (
+	s3Service     = "s3"
+	s3Algorithm   = "AWS4-HMAC-SHA256"
+	amzDateFormat = "20060102T150405Z"
+	emptySHA256   = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// NewS3Store - bucket mavjudligini tekshiradi va yo'q bo'lsa yaratadi
+func NewS3Store(ctx context.Context, opts S3Options) (*S3Store, error) {
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", opts.Endpoint)
+	}
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+
+	s := &S3Store{
+		endpoint: endpoint,
+		opts:     opts,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+	}
+	if err := s.ensureBucket(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Put - S3 PUT uchun hajm va SHA-256 oldindan ma'lum bo'lishi kerak,
+// shuning uchun oqim avval vaqtinchalik faylga yoziladi
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "s3-upload-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		return fmt.Errorf("buffer blob: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind blob: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), tmp)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req, hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return s3Error("put object", resp)
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := validKey(key); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req, emptySHA256)
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		return nil, s3Error("get object", resp)
+	}
+	return resp.Body, nil
+}
+
+// Stat - HEAD object, hajm Content-Length dan olinadi
+func (s *S3Store) Stat(ctx context.Context, key string) (Object, error) {
+	if err := validKey(key); err != nil {
+		return Object{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key), nil)
+	if err != nil {
+		return Object{}, err
+	}
+
+	resp, err := s.do(req, emptySHA256)
+	if err != nil {
+		return Object{}, fmt.Errorf("head object: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return Object{}, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		return Object{}, fmt.Errorf("head object: unexpected status %d", resp.StatusCode)
+	}
+	modTime, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+	return Object{Key: key, Size: resp.ContentLength, ModTime: modTime}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if err := validKey(key); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, emptySHA256)
+	if err != nil {
+		return fmt.Errorf("delete object: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return s3Error("delete object", resp)
+	}
+	return nil
+}
+
+// listResult - ListObjectsV2 javobining kerakli qismi
+type listResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List - ListObjectsV2 sahifalari bo'yicha (S3 kalitlarni o'sish tartibida qaytaradi)
+func (s *S3Store) List(ctx context.Context, prefix string, fn func(Object) error) error {
+	token := ""
+	for {
+		q := url.Values{}
+		q.Set("list-type", "2")
+		if prefix != "" {
+			q.Set("prefix", prefix)
+		}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.bucketURL()+"?"+q.Encode(), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.do(req, emptySHA256)
+		if err != nil {
+			return fmt.Errorf("list objects: %w", err)
+		}
+		if resp.StatusCode/100 != 2 {
+			err := s3Error("list objects", resp)
+			resp.Body.Close()
+			return err
+		}
+		var page listResult
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("decode object list: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if err := fn(Object{Key: obj.Key, Size: obj.Size, ModTime: obj.LastModified}); err != nil {
+				return err
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+// ensureBucket - HEAD bucket, 404 bo'lsa PUT bucket (lokal MinIO uchun qulay)
+func (s *S3Store) ensureBucket(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.bucketURL(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, emptySHA256)
+	if err != nil {
+		return fmt.Errorf("head bucket: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("head bucket: unexpected status %d", resp.StatusCode)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPut, s.bucketURL(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err = s.do(req, emptySHA256)
+	if err != nil {
+		return fmt.Errorf("create bucket: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return s3Error("create bucket", resp)
+	}
+	return nil
+}
+
+func (s *S3Store) bucketURL() string {
+	u := *s.endpoint
+	u.Path = "/" + s.opts.Bucket
+	return u.String()
+}
+
+func (s *S3Store) objectURL(key string) string {
+	u := *s.endpoint
+	u.Path = "/" + s.opts.Bucket + "/" + key
+	return u.String()
+}
+
+// do - so'rovni Signature V4 bilan imzolab yuboradi
+func (s *S3Store) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.sign(req, payloadHash, time.Now().UTC())
+	return s.client.Do(req)
+}
+
+func (s *S3Store) sign(req *http.Request, payloadHash string, now time.Time) {
+	// Kanonik so'rov satri: kalitlar tartiblangan, bo'sh joy %20 (S3 "+" ni qabul qilmaydi)
+	req.URL.RawQuery = strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20")
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	// 1. Kanonik so'rov
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-date":           amzDate,
+		"x-amz-content-sha256": payloadHash,
+	}
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		headers["content-type"] = ct
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	// 2. Imzolanadigan satr va imzo
+	scope := date + "/" + s.opts.Region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex(canonicalRequest)}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey(s.opts, date), stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.opts.AccessKey, scope, signedHeaders, signature))
+}
+
+// S3Signer - S3 uchun so'rov parametrlari bilan imzolangan (presigned) URL lar.
+// Tarmoqqa murojaat qilmaydi, shuning uchun bucket tekshiruvisiz yaratiladi
+type S3Signer struct {
+	endpoint *url.URL
+	opts     S3Options
+}
+
+func NewS3Signer(opts S3Options) (*S3Signer, error) {
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", opts.Endpoint)
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	return &S3Signer{endpoint: endpoint, opts: opts}, nil
+}
+
+func (s *S3Signer) SignURL(method, key string, ttl time.Duration) (string, error) {
+	if err := validKey(key); err != nil {
+		return "", err
+	}
+	u := *s.endpoint
+	u.Path = "/" + s.opts.Bucket + "/" + key
+	return presign(&u, method, s.opts, ttl, time.Now().UTC()), nil
+}
+
+// presign - X-Amz-* so'rov parametrlari bilan imzolangan URL. Faqat host sarlavhasi
+// imzolanadi, tana esa imzolanmaydi (UNSIGNED-PAYLOAD)
+func presign(u *url.URL, method string, opts S3Options, ttl time.Duration, now time.Time) string {
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	scope := date + "/" + opts.Region + "/" + s3Service + "/aws4_request"
+
+	q := url.Values{}
+	q.Set("X-Amz-Algorithm", s3Algorithm)
+	q.Set("X-Amz-Credential", opts.AccessKey+"/"+scope)
+	q.Set("X-Amz-Date", amzDate)
+	q.Set("X-Amz-Expires", strconv.FormatInt(int64(ttl/time.Second), 10))
+	q.Set("X-Amz-SignedHeaders", "host")
+	query := strings.ReplaceAll(q.Encode(), "+", "%20")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		query,
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, sha256Hex(canonicalRequest)}, "\n")
+	signature := hex.EncodeToString(hmacSHA256(signingKey(opts, date), stringToSign))
+
+	signed := *u
+	signed.RawQuery = query + "&X-Amz-Signature=" + signature
+	return signed.String()
+}
+
+// signingKey - Signature V4 kaliti: sana, region va servis bo'yicha HMAC zanjiri
+func signingKey(opts S3Options, date string) []byte {
+	key := hmacSHA256([]byte("AWS4"+opts.SecretKey), date)
+	key = hmacSHA256(key, opts.Region)
+	key = hmacSHA256(key, s3Service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func s3Error(op string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("%s: status %d: %s", op, resp.StatusCode, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,127 @@
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"wegugin/config"
+
+	"github.com/google/uuid"
+)
+
+// Signer - mijoz saqlash qatlamiga API serverni chetlab to'g'ridan-to'g'ri
+// murojaat qilishi uchun muddatli, imzolangan URL beradi
+type Signer interface {
+	// SignURL - method (PUT yoki GET) va key uchun ttl davomida amal qiladigan URL
+	SignURL(method, key string, ttl time.Duration) (string, error)
+}
+
+var (
+	// ErrInvalidSignature - imzo yo'q yoki mos kelmaydi
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrURLExpired - imzolangan URL muddati tugagan
+	ErrURLExpired = errors.New("signed URL expired")
+)
+
+// PrivatePrefix - bu prefiks ostidagi obyektlar faqat imzolangan URL orqali beriladi
+const PrivatePrefix = "private/"
+
+// IncomingRoot - to'g'ridan-to'g'ri yuklangan, hali tasdiqlanmagan fayllar
+const IncomingRoot = PrivatePrefix + "incoming/"
+
+// PrivateCarsRoot - yopiq media fayllari (hujjatlar va h.k.): private/cars/{car_id}/...
+const PrivateCarsRoot = PrivatePrefix + "cars/"
+
+// IsPrivate - kalit imzosiz berilmasligi kerakmi
+func IsPrivate(key string) bool {
+	return strings.HasPrefix(key, PrivatePrefix)
+}
+
+// IncomingPrefix - mashina uchun tasdiqlanmagan yuklashlar prefiksi
+func IncomingPrefix(carID string) string {
+	return IncomingRoot + "cars/" + carID + "/"
+}
+
+// IncomingKey - to'g'ridan-to'g'ri yuklash uchun yangi kalit
+func IncomingKey(carID string) string {
+	return IncomingPrefix(carID) + uuid.NewString()
+}
+
+// IsIncoming - kalit tasdiqlanmagan yuklash uchun berilganmi
+func IsIncoming(key string) bool {
+	return strings.HasPrefix(key, IncomingRoot)
+}
+
+// NewSigner - konfiguratsiyadagi BLOB_DRIVER bo'yicha URL imzolovchi
+func NewSigner(cfg config.BlobConfig) (Signer, error) {
+	switch cfg.BLOB_DRIVER {
+	case DriverLocal:
+		return NewHMACSigner(cfg.BLOB_PUBLIC_URL, cfg.BLOB_SIGNING_KEY), nil
+	case DriverS3:
+		endpoint := cfg.S3_PUBLIC_ENDPOINT
+		if endpoint == "" {
+			endpoint = cfg.S3_ENDPOINT
+		}
+		return NewS3Signer(S3Options{
+			Endpoint:  endpoint,
+			Region:    cfg.S3_REGION,
+			Bucket:    cfg.S3_BUCKET,
+			AccessKey: cfg.S3_ACCESS_KEY,
+			SecretKey: cfg.S3_SECRET_KEY,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", cfg.BLOB_DRIVER)
+	}
+}
+
+// HMACSigner - lokal saqlash uchun servisning o'z fayl manziliga imzolangan URL.
+// Imzo metod, kalit va muddatni qamrab oladi: {base}/{key}?expires=...&signature=...
+type HMACSigner struct {
+	baseURL string
+	secret  []byte
+}
+
+func NewHMACSigner(baseURL, secret string) *HMACSigner {
+	return &HMACSigner{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+	}
+}
+
+func (s *HMACSigner) SignURL(method, key string, ttl time.Duration) (string, error) {
+	if err := validKey(key); err != nil {
+		return "", err
+	}
+	expires := time.Now().Add(ttl).Unix()
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires, 10))
+	q.Set("signature", s.mac(method, key, expires))
+	return s.baseURL + "/" + key + "?" + q.Encode(), nil
+}
+
+// Verify - so'rovdagi expires va signature parametrlarini tekshiradi
+func (s *HMACSigner) Verify(method, key string, query url.Values) error {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(s.mac(method, key, expires)), []byte(query.Get("signature"))) {
+		return ErrInvalidSignature
+	}
+	if time.Now().Unix() > expires {
+		return ErrURLExpired
+	}
+	return nil
+}
+
+func (s *HMACSigner) mac(method, key string, expires int64) string {
+	h := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(h, "%s\n%s\n%d", method, key, expires)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,35 @@
+package blob
+
+import (
+	"path"
+	"strings"
+)
+
+// Variant - yuklangan rasmning qayta o'lchangan nusxasi (uzun tomoni MaxSide dan oshmaydi)
+type Variant struct {
+	Name    string
+	MaxSide int
+}
+
+// Variants - har bir yuklangan rasm uchun yaratiladigan o'lchamlar
+var Variants = []Variant{
+	{Name: "thumb", MaxSide: 200},
+	{Name: "medium", MaxSide: 800},
+	{Name: "large", MaxSide: 1600},
+}
+
+// IsVariant - nom Variants ro'yxatida bormi
+func IsVariant(name string) bool {
+	for _, v := range Variants {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// VariantKey - asl fayl kalitidan o'lcham kalitini hosil qiladi:
+// cars/{car_id}/{id}.png -> cars/{car_id}/{id}_thumb.jpg
+func VariantKey(key, name string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "_" + name + ".jpg"
+}
@@ -34,8 +34,27 @@ func ConnectionDb(ctx context.Context) (*sqlStore, error) {
 	}, nil
 }
 
+// ExecTx - berilgan funksiyani bitta tranzaksiya ichida bajaradi.
+// Funksiya xato qaytarsa, tranzaksiya bekor qilinadi (rollback).
+func (store *sqlStore) ExecTx(ctx context.Context, fn func(*db.Queries) error) error {
+	tx, err := store.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(store.Queries.WithTx(tx)); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 type Store interface {
 	db.Querier
+	ExecTx(ctx context.Context, fn func(*db.Queries) error) error
 }
 
 func New(ctx context.Context) (Store, error) {
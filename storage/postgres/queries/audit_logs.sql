@@ -0,0 +1,17 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_logs (actor_id, rpc, entity_type, entity_id, before, after, client_ip)
+VALUES (
+    sqlc.arg('actor_id'),
+    sqlc.arg('rpc'),
+    sqlc.arg('entity_type'),
+    sqlc.arg('entity_id'),
+    sqlc.arg('before'),
+    sqlc.arg('after'),
+    sqlc.arg('client_ip')
+);
+
+-- name: GetAuditTrail :many
+SELECT id, actor_id, rpc, entity_type, entity_id, before, after, client_ip, created_at
+FROM audit_logs
+WHERE entity_type = sqlc.arg('entity_type') AND entity_id = sqlc.arg('entity_id')
+ORDER BY created_at DESC;
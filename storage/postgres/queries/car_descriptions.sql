@@ -0,0 +1,20 @@
+-- name: UpsertCarDescription :exec
+INSERT INTO car_descriptions (car_id, locale, description)
+VALUES (sqlc.arg('car_id'), sqlc.arg('locale'), sqlc.arg('description'))
+ON CONFLICT (car_id, locale) DO UPDATE
+SET description = EXCLUDED.description, updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteCarDescription :exec
+DELETE FROM car_descriptions
+WHERE car_id = sqlc.arg('car_id') AND locale = sqlc.arg('locale');
+
+-- name: GetCarDescriptions :many
+SELECT locale, description
+FROM car_descriptions
+WHERE car_id = sqlc.arg('car_id')
+ORDER BY locale;
+
+-- name: GetCarDescriptionsByCarIds :many
+SELECT car_id, locale, description
+FROM car_descriptions
+WHERE car_id = ANY(sqlc.arg('car_ids')::UUID[]);
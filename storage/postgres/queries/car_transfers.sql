@@ -0,0 +1,37 @@
+-- name: CreateCarTransfer :one
+INSERT INTO car_transfers (car_id, from_user_id, to_user_id)
+VALUES (sqlc.arg('car_id'), sqlc.arg('from_user_id'), sqlc.arg('to_user_id'))
+ON CONFLICT (car_id) WHERE status = 'pending' DO NOTHING
+RETURNING id, car_id, from_user_id, to_user_id, status, created_at, responded_at;
+
+-- name: GetCarTransfer :one
+SELECT id, car_id, from_user_id, to_user_id, status, created_at, responded_at
+FROM car_transfers
+WHERE id = sqlc.arg('id');
+
+-- name: ListCarTransfersByUser :many
+SELECT id, car_id, from_user_id, to_user_id, status, created_at, responded_at
+FROM car_transfers
+WHERE (from_user_id = sqlc.arg('user_id') OR to_user_id = sqlc.arg('user_id'))
+    AND (sqlc.arg('status')::TEXT = '' OR status = sqlc.arg('status')::TEXT)
+ORDER BY created_at DESC;
+
+-- name: RespondCarTransfer :execrows
+UPDATE car_transfers
+SET status = sqlc.arg('status'), responded_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND status = 'pending';
+
+-- name: TransferCarOwner :execrows
+UPDATE cars
+SET owner_id = sqlc.arg('to_user_id'),
+    organization_id = CASE WHEN EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = cars.organization_id AND m.user_id = sqlc.arg('to_user_id')
+    ) THEN organization_id END,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND owner_id = sqlc.arg('from_user_id') AND deleted_at = 0;
+
+-- name: TransferPendingModerationOwner :exec
+UPDATE moderation_queue
+SET owner_id = sqlc.arg('to_user_id')
+WHERE entity_type = 'car' AND entity_id = sqlc.arg('car_id') AND status = 'pending';
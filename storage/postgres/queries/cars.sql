@@ -30,41 +30,54 @@ RETURNING
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.moderation_status, c.organization_id,
+    c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
-WHERE c.id = sqlc.arg('id')
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
+WHERE c.id = sqlc.arg('id') AND c.deleted_at = 0
 GROUP BY c.id;
 
 -- name: ListCars :many
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
 WHERE 
-    (sqlc.arg('type')::TEXT IS NULL OR c.type = sqlc.arg('type')::TEXT)
+    c.deleted_at = 0
+    AND c.moderation_status = 'approved'
+    AND (sqlc.arg('type')::TEXT IS NULL OR c.type = sqlc.arg('type')::TEXT)
     AND (sqlc.arg('location')::TEXT IS NULL OR c.location = sqlc.arg('location')::TEXT)
     -- Add price range filters
     AND (sqlc.arg('min_price')::DECIMAL(10,2) IS NULL OR c.price >= sqlc.arg('min_price')::DECIMAL(10,2))
@@ -91,15 +104,26 @@ SET type = sqlc.arg('type'), make = sqlc.arg('make'), model = sqlc.arg('model'),
     color = sqlc.arg('color'), mileage = sqlc.arg('mileage'), price = sqlc.arg('price'),
     description = sqlc.arg('description'), available = sqlc.arg('available'), location = sqlc.arg('location'), 
     updated_at = CURRENT_TIMESTAMP
-WHERE id = sqlc.arg('id');
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
 
 -- name: DeleteCar :exec
-DELETE FROM cars WHERE id = sqlc.arg('id');
+UPDATE cars
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: RestoreCar :execrows
+UPDATE cars
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND deleted_at <> 0;
+
+-- name: PurgeDeletedCars :execrows
+DELETE FROM cars
+WHERE deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT;
 
 -- name: IncrementCarReviewCount :exec
 UPDATE cars
 SET reviews_count = reviews_count + 1
-WHERE id = sqlc.arg('id');
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
 
 -- name: CheckCarOwnership :one
 SELECT EXISTS (
@@ -112,26 +136,77 @@ SELECT EXISTS (
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
 WHERE 
+    c.deleted_at = 0 AND c.moderation_status = 'approved' AND (
     COALESCE(sqlc.arg('query'), '') = '' OR 
     c.make ILIKE '%' || sqlc.arg('query') || '%' OR 
     c.model ILIKE '%' || sqlc.arg('query') || '%'
+    )
 GROUP BY 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
     c.created_at, c.updated_at
 ORDER BY c.created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: GetPriceComparables :one
+SELECT
+    COUNT(*) AS comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS low_price,
+    COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS median_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS high_price
+FROM cars
+WHERE deleted_at = 0
+    AND moderation_status = 'approved'
+    AND LOWER(make) = LOWER(sqlc.arg('make')::TEXT)
+    AND LOWER(model) = LOWER(sqlc.arg('model')::TEXT)
+    AND year BETWEEN sqlc.arg('min_year')::INTEGER AND sqlc.arg('max_year')::INTEGER
+    AND mileage BETWEEN sqlc.arg('min_mileage')::INTEGER AND sqlc.arg('max_mileage')::INTEGER
+    AND (sqlc.arg('location')::TEXT = '' OR LOWER(location) = LOWER(sqlc.arg('location')::TEXT))
+    AND (sqlc.narg('exclude_id')::UUID IS NULL OR id <> sqlc.narg('exclude_id')::UUID);
+
+-- name: GetPriceComparablesBatch :many
+SELECT
+    t.id AS car_id,
+    COUNT(c.id) FILTER (WHERE LOWER(c.location) = LOWER(t.location)) AS local_comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY c.price) FILTER (WHERE LOWER(c.location) = LOWER(t.location)), 0)::FLOAT8 AS local_low_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY c.price) FILTER (WHERE LOWER(c.location) = LOWER(t.location)), 0)::FLOAT8 AS local_high_price,
+    COUNT(c.id) AS comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY c.price), 0)::FLOAT8 AS low_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY c.price), 0)::FLOAT8 AS high_price
+FROM unnest(
+    sqlc.arg('ids')::UUID[],
+    sqlc.arg('makes')::TEXT[],
+    sqlc.arg('models')::TEXT[],
+    sqlc.arg('min_years')::INTEGER[],
+    sqlc.arg('max_years')::INTEGER[],
+    sqlc.arg('min_mileages')::INTEGER[],
+    sqlc.arg('max_mileages')::INTEGER[],
+    sqlc.arg('locations')::TEXT[]
+) AS t(id, make, model, min_year, max_year, min_mileage, max_mileage, location)
+LEFT JOIN cars c ON c.deleted_at = 0
+    AND c.moderation_status = 'approved'
+    AND LOWER(c.make) = LOWER(t.make)
+    AND LOWER(c.model) = LOWER(t.model)
+    AND c.year BETWEEN t.min_year AND t.max_year
+    AND c.mileage BETWEEN t.min_mileage AND t.max_mileage
+    AND c.id <> t.id
+GROUP BY t.id;
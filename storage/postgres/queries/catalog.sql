@@ -0,0 +1,94 @@
+-- name: UpsertCarMake :one
+INSERT INTO car_makes (name, name_key)
+VALUES (sqlc.arg('name'), sqlc.arg('name_key'))
+ON CONFLICT (name_key) DO UPDATE SET name = EXCLUDED.name
+RETURNING id, name;
+
+-- name: UpsertCarModel :one
+INSERT INTO car_models (make_id, name, name_key)
+VALUES (sqlc.arg('make_id'), sqlc.arg('name'), sqlc.arg('name_key'))
+ON CONFLICT (make_id, name_key) DO UPDATE SET name = EXCLUDED.name
+RETURNING id, name;
+
+-- name: UpsertCarGeneration :one
+INSERT INTO car_generations (model_id, name, year_from, year_to)
+VALUES (sqlc.arg('model_id'), sqlc.arg('name'), sqlc.arg('year_from'), sqlc.arg('year_to'))
+ON CONFLICT (model_id, name) DO UPDATE SET year_from = EXCLUDED.year_from, year_to = EXCLUDED.year_to
+RETURNING id, name, year_from, year_to;
+
+-- name: AddCarMakeAlias :one
+WITH inserted AS (
+    INSERT INTO car_make_aliases (alias_key, make_id)
+    VALUES (sqlc.arg('alias_key'), sqlc.arg('make_id'))
+    ON CONFLICT (alias_key) DO NOTHING
+    RETURNING make_id
+)
+SELECT make_id FROM inserted
+UNION ALL
+SELECT make_id FROM car_make_aliases WHERE alias_key = sqlc.arg('alias_key')
+LIMIT 1;
+
+-- name: AddCarModelAlias :exec
+INSERT INTO car_model_aliases (model_id, alias_key)
+VALUES (sqlc.arg('model_id'), sqlc.arg('alias_key'))
+ON CONFLICT DO NOTHING;
+
+-- name: CarMakeKeyTaken :one
+SELECT EXISTS (
+    SELECT 1 FROM car_makes WHERE name_key = sqlc.arg('key') AND id <> sqlc.arg('make_id')
+) AS taken;
+
+-- name: CarModelKeyTaken :one
+SELECT EXISTS (
+    SELECT 1 FROM car_models
+    WHERE make_id = sqlc.arg('make_id') AND name_key = sqlc.arg('key') AND id <> sqlc.arg('model_id')
+) OR EXISTS (
+    SELECT 1 FROM car_model_aliases a
+    JOIN car_models md ON md.id = a.model_id
+    WHERE md.make_id = sqlc.arg('make_id') AND a.alias_key = sqlc.arg('key') AND a.model_id <> sqlc.arg('model_id')
+) AS taken;
+
+-- name: ResolveCarMake :one
+SELECT mk.id, mk.name
+FROM car_makes mk
+WHERE mk.name_key = sqlc.arg('key')
+    OR mk.id = (SELECT a.make_id FROM car_make_aliases a WHERE a.alias_key = sqlc.arg('key'))
+ORDER BY (mk.name_key = sqlc.arg('key')) DESC
+LIMIT 1;
+
+-- name: ResolveCarModel :one
+SELECT md.id, md.name
+FROM car_models md
+WHERE md.make_id = sqlc.arg('make_id')
+    AND (md.name_key = sqlc.arg('key')
+        OR md.id IN (SELECT a.model_id FROM car_model_aliases a WHERE a.alias_key = sqlc.arg('key')))
+ORDER BY (md.name_key = sqlc.arg('key')) DESC, md.name_key
+LIMIT 1;
+
+-- name: AutocompleteCarMakes :many
+SELECT DISTINCT mk.name
+FROM car_makes mk
+LEFT JOIN car_make_aliases a ON a.make_id = mk.id
+WHERE mk.name_key LIKE sqlc.arg('prefix')::TEXT || '%'
+    OR a.alias_key LIKE sqlc.arg('prefix')::TEXT || '%'
+ORDER BY mk.name
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: AutocompleteCarModels :many
+SELECT DISTINCT mk.name AS make_name, md.name AS model_name
+FROM car_models md
+JOIN car_makes mk ON mk.id = md.make_id
+LEFT JOIN car_model_aliases a ON a.model_id = md.id
+WHERE (sqlc.narg('make_id')::UUID IS NULL OR md.make_id = sqlc.narg('make_id')::UUID)
+    AND (md.name_key LIKE sqlc.arg('prefix')::TEXT || '%' OR a.alias_key LIKE sqlc.arg('prefix')::TEXT || '%')
+ORDER BY mk.name, md.name
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: DeleteCarMake :execrows
+DELETE FROM car_makes WHERE id = sqlc.arg('id');
+
+-- name: DeleteCarModel :execrows
+DELETE FROM car_models WHERE id = sqlc.arg('id');
+
+-- name: DeleteCarGeneration :execrows
+DELETE FROM car_generations WHERE id = sqlc.arg('id');
@@ -1,39 +1,206 @@
 -- name: CreateComment :one
-INSERT INTO comments (
-    "user_id",
-    "car_id",
-    "content"
-) VALUES (
-    sqlc.arg('user_id'),
-    sqlc.arg('car_id'),
-    sqlc.arg('content')
+WITH inserted AS (
+    INSERT INTO comments (
+        "user_id",
+        "car_id",
+        "content",
+        "parent_id",
+        "depth"
+    ) VALUES (
+        sqlc.arg('user_id'),
+        sqlc.arg('car_id'),
+        sqlc.arg('content'),
+        sqlc.narg('parent_id'),
+        COALESCE((SELECT depth + 1 FROM comments WHERE id = sqlc.narg('parent_id')), 0)
+    )
+    RETURNING id, user_id, car_id, content, created_at, updated_at, parent_id, depth
 )
-RETURNING 
-    id, user_id, car_id, content, created_at, updated_at;
+SELECT
+    i.id, i.user_id, i.car_id, i.content, i.created_at, i.updated_at, i.parent_id, i.depth,
+    (i.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = i.user_id
+    ))::BOOLEAN AS is_seller
+FROM inserted i
+JOIN cars c ON c.id = i.car_id;
 
--- name: GetCommentsByCar :many
+-- name: GetCommentsByCarNewest :many
 SELECT 
-    id, user_id, car_id, content, created_at, updated_at
-FROM comments
-WHERE car_id = sqlc.arg('car_id') AND deleted_at = 0;
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = sqlc.arg('car_id') AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND (sqlc.narg('cursor_id')::UUID IS NULL
+        OR (cm.created_at, cm.id) < (sqlc.narg('cursor_created_at')::TIMESTAMPTZ, sqlc.narg('cursor_id')::UUID))
+ORDER BY cm.created_at DESC, cm.id DESC
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: GetCommentsByCarOldest :many
+SELECT 
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = sqlc.arg('car_id') AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND (sqlc.narg('cursor_id')::UUID IS NULL
+        OR (cm.created_at, cm.id) > (sqlc.narg('cursor_created_at')::TIMESTAMPTZ, sqlc.narg('cursor_id')::UUID))
+ORDER BY cm.created_at, cm.id
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: GetCommentsByCarMostLiked :many
+SELECT 
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = sqlc.arg('car_id') AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND (sqlc.narg('cursor_id')::UUID IS NULL
+        OR (cm.like_count, cm.created_at, cm.id) < (sqlc.narg('cursor_likes')::INTEGER, sqlc.narg('cursor_created_at')::TIMESTAMPTZ, sqlc.narg('cursor_id')::UUID))
+ORDER BY cm.like_count DESC, cm.created_at DESC, cm.id DESC
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: GetCommentReplies :many
+WITH RECURSIVE thread AS (
+    SELECT cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+        cm.parent_id AS root_id
+    FROM comments cm
+    WHERE cm.parent_id = ANY(sqlc.arg('root_ids')::UUID[]) AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    UNION ALL
+    SELECT cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+        t.root_id
+    FROM comments cm
+    JOIN thread t ON cm.parent_id = t.id
+    WHERE cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+), ranked AS (
+    SELECT thread.*, ROW_NUMBER() OVER (PARTITION BY root_id ORDER BY created_at, id) AS n
+    FROM thread
+)
+SELECT 
+    t.id, t.user_id, t.car_id, t.content, t.created_at, t.updated_at, t.parent_id, t.depth, t.like_count,
+    (t.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = t.user_id
+    ))::BOOLEAN AS is_seller
+FROM ranked t
+JOIN cars c ON c.id = t.car_id
+WHERE t.n <= sqlc.arg('reply_limit')::INTEGER
+ORDER BY t.created_at, t.id;
+
+-- name: CountCarComments :one
+WITH RECURSIVE thread AS (
+    SELECT id FROM comments
+    WHERE car_id = sqlc.arg('car_id') AND parent_id IS NULL AND deleted_at = 0 AND moderation_status = 'approved'
+    UNION ALL
+    SELECT cm.id FROM comments cm
+    JOIN thread t ON cm.parent_id = t.id
+    WHERE cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+)
+SELECT COUNT(*) FROM thread;
 
 -- name: UpdateComment :exec
 UPDATE comments
 SET 
     content = sqlc.arg('content'), 
     updated_at = CURRENT_TIMESTAMP
-WHERE id = sqlc.arg('id');
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
 
 -- name: DeleteComment :exec
-DELETE FROM comments 
-WHERE id = sqlc.arg('id');
+WITH RECURSIVE thread AS (
+    SELECT id FROM comments WHERE id = sqlc.arg('id') AND deleted_at = 0
+    UNION ALL
+    SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+    WHERE c.deleted_at = 0
+)
+UPDATE comments
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id IN (SELECT id FROM thread);
 
 -- name: DeleteCommentsByCarId :exec
-DELETE FROM comments 
-WHERE car_id = sqlc.arg('car_id');
+UPDATE comments
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE car_id = sqlc.arg('car_id') AND deleted_at = 0;
+
+-- name: RestoreComment :execrows
+WITH RECURSIVE target AS (
+    SELECT id, deleted_at FROM comments
+    WHERE id = sqlc.arg('id') AND deleted_at <> 0
+        AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0)
+        AND (parent_id IS NULL OR parent_id IN (SELECT id FROM comments WHERE deleted_at = 0))
+), thread AS (
+    SELECT id FROM target
+    UNION ALL
+    SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+    WHERE c.deleted_at = (SELECT deleted_at FROM target)
+)
+UPDATE comments
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id IN (SELECT id FROM thread);
+
+-- name: RestoreCommentsByCarId :exec
+UPDATE comments
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE car_id = sqlc.arg('car_id') AND deleted_at <> 0
+    AND deleted_at = (SELECT deleted_at FROM cars WHERE id = sqlc.arg('car_id'));
+
+-- name: PurgeDeletedComments :execrows
+DELETE FROM comments
+WHERE (deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT)
+    OR car_id IN (
+        SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT
+    );
 
 -- name: CheckCommentOwnership :one
 SELECT EXISTS (
     SELECT 1 FROM comments 
     WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
 ) AS is_owner;
+
+-- name: GetCommentById :one
+SELECT 
+    id, user_id, car_id, content, created_at, updated_at
+FROM comments
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: GetCommentThreadInfo :one
+SELECT car_id, depth, moderation_status
+FROM comments
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: UpsertCommentReaction :exec
+INSERT INTO comment_reactions (comment_id, user_id, reaction)
+VALUES (sqlc.arg('comment_id'), sqlc.arg('user_id'), sqlc.arg('reaction'))
+ON CONFLICT (comment_id, user_id) DO UPDATE
+SET reaction = EXCLUDED.reaction, created_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteCommentReaction :execrows
+DELETE FROM comment_reactions
+WHERE comment_id = sqlc.arg('comment_id') AND user_id = sqlc.arg('user_id');
+
+-- name: RefreshCommentLikeCount :exec
+UPDATE comments
+SET like_count = (
+    SELECT COUNT(*) FROM comment_reactions
+    WHERE comment_id = sqlc.arg('id') AND reaction = 'like'
+)
+WHERE id = sqlc.arg('id');
+
+-- name: GetCommentReactionCounts :many
+SELECT comment_id, reaction, COUNT(*) AS count
+FROM comment_reactions
+WHERE comment_id = ANY(sqlc.arg('comment_ids')::UUID[])
+GROUP BY comment_id, reaction;
+
+-- name: GetUserCommentReactions :many
+SELECT comment_id, reaction
+FROM comment_reactions
+WHERE user_id = sqlc.arg('user_id') AND comment_id = ANY(sqlc.arg('comment_ids')::UUID[]);
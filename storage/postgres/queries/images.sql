@@ -1,20 +1,155 @@
 -- name: AddImage :one
-INSERT INTO images (car_id, filename)
-VALUES (sqlc.arg('car_id'), sqlc.arg('filename'))
-RETURNING id, car_id, filename, uploaded_at;
+INSERT INTO images (car_id, filename, variants, width, height, size_bytes, checksum, phash, mime_type, position, is_cover)
+VALUES (
+    sqlc.arg('car_id'), sqlc.arg('filename'), sqlc.arg('variants')::TEXT[],
+    sqlc.narg('width'), sqlc.narg('height'), sqlc.narg('size_bytes'), sqlc.narg('checksum'), sqlc.narg('phash'), sqlc.narg('mime_type'),
+    COALESCE((SELECT MAX(position) + 1 FROM images WHERE car_id = sqlc.arg('car_id') AND kind = 'image' AND deleted_at = 0), 0),
+    NOT EXISTS (SELECT 1 FROM images WHERE car_id = sqlc.arg('car_id') AND is_cover AND deleted_at = 0)
+)
+RETURNING id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum;
 
 -- name: GetImagesByCar :many
-SELECT id, car_id, filename, uploaded_at
+SELECT id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum
 FROM images
-WHERE car_id = sqlc.arg('car_id');
+WHERE car_id = sqlc.arg('car_id') AND kind = 'image' AND deleted_at = 0
+ORDER BY position, uploaded_at;
 
 -- name: GetImageById :one
-SELECT id, car_id, filename, uploaded_at
+SELECT id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum
 FROM images
-WHERE id = sqlc.arg('id');
+WHERE id = sqlc.arg('id') AND kind = 'image' AND deleted_at = 0;
 
 -- name: DeleteImage :exec
-DELETE FROM images WHERE id = sqlc.arg('id');
+UPDATE images
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, is_cover = FALSE
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
 
 -- name: DeleteImagesByCarId :exec
-DELETE FROM images WHERE car_id = sqlc.arg('car_id');
+UPDATE images
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, is_cover = FALSE
+WHERE car_id = sqlc.arg('car_id') AND deleted_at = 0;
+
+-- name: RestoreImage :execrows
+UPDATE images
+SET deleted_at = 0
+WHERE id = sqlc.arg('id') AND kind = 'image' AND deleted_at <> 0
+    AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0);
+
+-- name: GetDeletedImageCar :one
+SELECT i.car_id, c.owner_id
+FROM images i
+JOIN cars c ON c.id = i.car_id
+WHERE i.id = sqlc.arg('id') AND i.kind = 'image' AND i.deleted_at <> 0 AND c.deleted_at = 0;
+
+-- name: RestoreImagesByCarId :exec
+UPDATE images
+SET deleted_at = 0
+WHERE car_id = sqlc.arg('car_id') AND deleted_at <> 0
+    AND deleted_at = (SELECT deleted_at FROM cars WHERE id = sqlc.arg('car_id'));
+
+-- name: PurgeDeletedImages :execrows
+DELETE FROM images
+WHERE (deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT)
+    OR car_id IN (
+        SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT
+    );
+
+-- name: CheckImageOwnership :one
+SELECT EXISTS (
+    SELECT 1 FROM images i
+    JOIN cars c ON c.id = i.car_id
+    WHERE i.id = sqlc.arg('id') AND c.owner_id = sqlc.arg('owner_id')
+) AS is_owner;
+
+-- name: SetImagePositions :exec
+UPDATE images i
+SET position = o.ord - 1
+FROM unnest(sqlc.arg('image_ids')::UUID[]) WITH ORDINALITY AS o(id, ord)
+WHERE i.id = o.id AND i.car_id = sqlc.arg('car_id');
+
+-- name: ClearCoverImage :exec
+UPDATE images SET is_cover = FALSE
+WHERE car_id = sqlc.arg('car_id') AND is_cover;
+
+-- name: SetCoverImage :execrows
+UPDATE images SET is_cover = TRUE
+WHERE id = sqlc.arg('id') AND car_id = sqlc.arg('car_id') AND kind = 'image' AND deleted_at = 0;
+
+-- name: EnsureCoverImage :exec
+UPDATE images SET is_cover = TRUE
+WHERE id = (
+    SELECT id FROM images
+    WHERE car_id = sqlc.arg('car_id') AND kind = 'image' AND deleted_at = 0
+    ORDER BY position, uploaded_at
+    LIMIT 1
+) AND NOT EXISTS (SELECT 1 FROM images WHERE car_id = sqlc.arg('car_id') AND is_cover AND deleted_at = 0);
+
+-- name: ListCarImageFiles :many
+SELECT filename, variants, deleted_at, uploaded_at
+FROM images
+WHERE car_id = sqlc.arg('car_id');
+
+-- name: FindSimilarImages :many
+SELECT i.id, i.car_id, c.owner_id, d.distance::INTEGER AS distance
+FROM images i
+JOIN cars c ON c.id = i.car_id
+CROSS JOIN LATERAL (
+    SELECT length(replace((i.phash # sqlc.arg('phash')::BIGINT)::BIT(64)::TEXT, '0', '')) AS distance
+) d
+WHERE i.phash IS NOT NULL AND i.kind = 'image' AND i.deleted_at = 0
+    AND (i.phash_b0 = ANY(sqlc.arg('band0')::INTEGER[])
+        OR i.phash_b1 = ANY(sqlc.arg('band1')::INTEGER[])
+        OR i.phash_b2 = ANY(sqlc.arg('band2')::INTEGER[])
+        OR i.phash_b3 = ANY(sqlc.arg('band3')::INTEGER[]))
+    AND c.deleted_at = 0
+    AND c.owner_id <> sqlc.arg('owner_id')
+    AND d.distance <= sqlc.arg('max_distance')::INTEGER
+ORDER BY d.distance, i.uploaded_at
+LIMIT 10;
+
+-- name: CreateImageMatch :exec
+INSERT INTO image_matches (image_id, matched_image_id, distance)
+VALUES (sqlc.arg('image_id'), sqlc.arg('matched_image_id'), sqlc.arg('distance'))
+ON CONFLICT (image_id, matched_image_id) DO NOTHING;
+
+-- name: ListImageMatches :many
+SELECT m.id, m.distance, m.created_at,
+    i.id AS image_id, i.car_id, c.owner_id, i.filename,
+    mi.id AS matched_image_id, mi.car_id AS matched_car_id, mc.owner_id AS matched_owner_id, mi.filename AS matched_filename
+FROM image_matches m
+JOIN images i ON i.id = m.image_id
+JOIN cars c ON c.id = i.car_id
+JOIN images mi ON mi.id = m.matched_image_id
+JOIN cars mc ON mc.id = mi.car_id
+WHERE sqlc.narg('car_id')::UUID IS NULL
+    OR i.car_id = sqlc.narg('car_id')::UUID
+    OR mi.car_id = sqlc.narg('car_id')::UUID
+ORDER BY m.created_at DESC
+LIMIT sqlc.arg('limit')::INTEGER OFFSET sqlc.arg('offset')::INTEGER;
+
+-- name: AddMedia :one
+INSERT INTO images (car_id, filename, variants, kind, mime_type, visibility, size_bytes, checksum, position)
+VALUES (
+    sqlc.arg('car_id'), sqlc.arg('filename'), '{}', sqlc.arg('kind'), sqlc.arg('mime_type'), sqlc.arg('visibility'),
+    sqlc.narg('size_bytes'), sqlc.narg('checksum'),
+    COALESCE((SELECT MAX(position) + 1 FROM images WHERE car_id = sqlc.arg('car_id') AND kind = sqlc.arg('kind') AND deleted_at = 0), 0)
+)
+RETURNING id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum;
+
+-- name: ListCarMedia :many
+SELECT id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum
+FROM images
+WHERE car_id = sqlc.arg('car_id') AND deleted_at = 0
+    AND (sqlc.narg('kind')::TEXT IS NULL OR kind = sqlc.narg('kind')::TEXT)
+    AND (visibility = 'public' OR sqlc.arg('include_private')::BOOLEAN)
+ORDER BY kind, position, uploaded_at;
+
+-- name: GetMediaById :one
+SELECT id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum
+FROM images
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: CountCarMedia :one
+SELECT COUNT(*)
+FROM images
+WHERE car_id = sqlc.arg('car_id') AND kind <> 'image' AND deleted_at = 0;
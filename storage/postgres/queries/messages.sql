@@ -1,7 +1,7 @@
 -- name: CreateMessage :one
-INSERT INTO messages (sender_id, recipient_id, content)
-VALUES (sqlc.arg('sender_id'), sqlc.arg('recipient_id'), sqlc.arg('content'))
-RETURNING id, sender_id, recipient_id, content, read, created_at, updated_at;
+INSERT INTO messages (sender_id, recipient_id, content, car_id)
+VALUES (sqlc.arg('sender_id'), sqlc.arg('recipient_id'), sqlc.arg('content'), sqlc.narg('car_id'))
+RETURNING id, sender_id, recipient_id, content, read, created_at, updated_at, car_id;
 
 -- name: GetMessagesByUser :many
 SELECT 
@@ -21,7 +21,8 @@ SELECT
         ) ORDER BY created_at ASC
     ) AS messages
 FROM messages
-WHERE sender_id = sqlc.arg('user_id') OR recipient_id = sqlc.arg('user_id')
+WHERE (sender_id = sqlc.arg('user_id') OR recipient_id = sqlc.arg('user_id'))
+    AND NOT hidden
 GROUP BY user_id;
 
 -- name: MarkMessageAsRead :exec
@@ -34,10 +35,30 @@ DELETE FROM messages WHERE id = sqlc.arg('id');
 
 -- name: CheckMessageOwnership :one
 SELECT EXISTS (
-    SELECT 1 FROM messages 
-    WHERE id = sqlc.arg('id') AND (sender_id = sqlc.arg('user_id') OR recipient_id = sqlc.arg('user_id'))
+    SELECT 1 FROM messages m
+    WHERE m.id = sqlc.arg('id') AND (
+        m.sender_id = sqlc.arg('user_id') OR m.recipient_id = sqlc.arg('user_id')
+        OR EXISTS (
+            SELECT 1 FROM cars c
+            JOIN organization_members om ON om.org_id = c.organization_id
+            WHERE c.id = m.car_id AND om.user_id = sqlc.arg('user_id')
+        )
+    )
 ) AS is_owner;
 
+-- name: CheckMessageCarParticipant :one
+SELECT EXISTS (
+    SELECT 1 FROM cars c
+    WHERE c.id = sqlc.arg('car_id') AND c.deleted_at = 0 AND (
+        c.owner_id IN (sqlc.arg('sender_id'), sqlc.arg('recipient_id'))
+        OR EXISTS (
+            SELECT 1 FROM organization_members om
+            WHERE om.org_id = c.organization_id
+                AND om.user_id IN (sqlc.arg('sender_id'), sqlc.arg('recipient_id'))
+        )
+    )
+) AS is_participant;
+
 -- name: GetMessagesByUserAndId :many
 SELECT 
     id,
@@ -49,7 +70,13 @@ SELECT
     updated_at
 FROM messages
 WHERE 
-    (sender_id = sqlc.arg('user1_id') AND recipient_id = sqlc.arg('user2_id'))
+    ((sender_id = sqlc.arg('user1_id') AND recipient_id = sqlc.arg('user2_id'))
     OR 
-    (sender_id = sqlc.arg('user2_id') AND recipient_id = sqlc.arg('user1_id'))
+    (sender_id = sqlc.arg('user2_id') AND recipient_id = sqlc.arg('user1_id')))
+    AND NOT hidden
 ORDER BY created_at ASC;
+
+-- name: SetMessageHidden :exec
+UPDATE messages
+SET hidden = sqlc.arg('hidden')
+WHERE id = sqlc.arg('id');
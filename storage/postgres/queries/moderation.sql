@@ -0,0 +1,71 @@
+-- name: EnqueueModeration :exec
+INSERT INTO moderation_queue (entity_type, entity_id, owner_id)
+VALUES (sqlc.arg('entity_type'), sqlc.arg('entity_id'), sqlc.arg('owner_id'));
+
+-- name: EnqueueModerationIfAbsent :exec
+INSERT INTO moderation_queue (entity_type, entity_id, owner_id)
+SELECT sqlc.arg('entity_type')::VARCHAR, sqlc.arg('entity_id')::UUID, sqlc.arg('owner_id')::UUID
+WHERE NOT EXISTS (
+    SELECT 1 FROM moderation_queue
+    WHERE entity_type = sqlc.arg('entity_type') AND entity_id = sqlc.arg('entity_id') AND status = 'pending'
+);
+
+-- name: ListPendingModeration :many
+SELECT id, entity_type, entity_id, owner_id, status, created_at
+FROM moderation_queue
+WHERE status = 'pending'
+    AND (sqlc.arg('entity_type')::TEXT = '' OR entity_type = sqlc.arg('entity_type')::TEXT)
+ORDER BY created_at ASC
+LIMIT sqlc.arg('limit')::INTEGER OFFSET sqlc.arg('offset')::INTEGER;
+
+-- name: GetModerationItem :one
+SELECT id, entity_type, entity_id, owner_id, status, created_at
+FROM moderation_queue
+WHERE id = sqlc.arg('id');
+
+-- name: ReviewModerationItem :execrows
+UPDATE moderation_queue
+SET status = sqlc.arg('status'),
+    reason = sqlc.arg('reason'),
+    reviewed_by = sqlc.arg('reviewed_by'),
+    reviewed_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND status = 'pending';
+
+-- name: SetCarModerationStatus :exec
+UPDATE cars
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id');
+
+-- name: SetCommentModerationStatus :exec
+UPDATE comments
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id');
+
+-- name: SetReviewModerationStatus :exec
+UPDATE reviews
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id');
+
+-- name: GetCarModerationStatus :one
+SELECT moderation_status FROM cars WHERE id = sqlc.arg('id');
+
+-- name: GetCommentModerationStatus :one
+SELECT moderation_status FROM comments WHERE id = sqlc.arg('id');
+
+-- name: GetReviewModerationStatus :one
+SELECT moderation_status FROM reviews WHERE id = sqlc.arg('id');
+
+-- name: TransitionCarModerationStatus :exec
+UPDATE cars
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id') AND moderation_status = sqlc.arg('from_status');
+
+-- name: TransitionCommentModerationStatus :exec
+UPDATE comments
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id') AND moderation_status = sqlc.arg('from_status');
+
+-- name: TransitionReviewModerationStatus :exec
+UPDATE reviews
+SET moderation_status = sqlc.arg('moderation_status')
+WHERE id = sqlc.arg('id') AND moderation_status = sqlc.arg('from_status');
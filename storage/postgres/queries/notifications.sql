@@ -19,4 +19,21 @@ SET seen = true, updated_at = CURRENT_TIMESTAMP
 WHERE id = sqlc.arg('id');
 
 -- name: DeleteNotification :exec
-DELETE FROM notifications WHERE id = sqlc.arg('id');
+UPDATE notifications
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: RestoreNotification :execrows
+UPDATE notifications
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id') AND deleted_at <> 0;
+
+-- name: PurgeDeletedNotifications :execrows
+DELETE FROM notifications
+WHERE deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT;
+
+-- name: CheckNotificationOwnership :one
+SELECT EXISTS (
+    SELECT 1 FROM notifications
+    WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+) AS is_owner;
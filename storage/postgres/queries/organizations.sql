@@ -0,0 +1,60 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (name, created_by)
+VALUES (sqlc.arg('name'), sqlc.arg('created_by'))
+RETURNING id, name, created_by, created_at;
+
+-- name: GetOrganization :one
+SELECT id, name, created_by, created_at
+FROM organizations
+WHERE id = sqlc.arg('id');
+
+-- name: ListUserOrganizations :many
+SELECT o.id, o.name, m.role
+FROM organizations o
+JOIN organization_members m ON m.org_id = o.id
+WHERE m.user_id = sqlc.arg('user_id')
+ORDER BY o.name;
+
+-- name: UpsertOrganizationMember :exec
+INSERT INTO organization_members (org_id, user_id, role)
+VALUES (sqlc.arg('org_id'), sqlc.arg('user_id'), sqlc.arg('role'))
+ON CONFLICT (org_id, user_id) DO UPDATE SET role = EXCLUDED.role;
+
+-- name: RemoveOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE org_id = sqlc.arg('org_id') AND user_id = sqlc.arg('user_id');
+
+-- name: ListOrganizationMembers :many
+SELECT user_id, role, created_at
+FROM organization_members
+WHERE org_id = sqlc.arg('org_id')
+ORDER BY created_at;
+
+-- name: GetOrganizationMemberRole :one
+SELECT role
+FROM organization_members
+WHERE org_id = sqlc.arg('org_id') AND user_id = sqlc.arg('user_id');
+
+-- name: CountOrganizationOwners :one
+SELECT COUNT(*)
+FROM organization_members
+WHERE org_id = sqlc.arg('org_id') AND role = 'owner';
+
+-- name: GetCarMemberRole :one
+SELECT m.role
+FROM cars c
+JOIN organization_members m ON m.org_id = c.organization_id
+WHERE c.id = sqlc.arg('car_id') AND m.user_id = sqlc.arg('user_id');
+
+-- name: SetCarOrganization :exec
+UPDATE cars
+SET organization_id = sqlc.narg('organization_id'), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id');
+
+-- name: ListOrganizationMessages :many
+SELECT m.id, m.sender_id, m.recipient_id, m.content, m.read, m.created_at, m.car_id
+FROM messages m
+JOIN cars c ON c.id = m.car_id
+WHERE c.organization_id = sqlc.arg('org_id') AND NOT m.hidden
+ORDER BY m.created_at DESC
+LIMIT sqlc.arg('limit')::INTEGER OFFSET sqlc.arg('offset')::INTEGER;
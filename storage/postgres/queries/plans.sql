@@ -0,0 +1,34 @@
+-- name: GetUserPlan :one
+SELECT code, name, max_active_listings, max_images_per_car
+FROM plans
+WHERE code = COALESCE((
+    SELECT up.plan_code FROM user_plans up
+    WHERE up.user_id = sqlc.arg('user_id')
+      AND (up.expires_at IS NULL OR up.expires_at > CURRENT_TIMESTAMP)
+), 'free');
+
+-- name: SetUserPlan :execrows
+INSERT INTO user_plans (user_id, plan_code, expires_at)
+SELECT sqlc.arg('user_id')::UUID, p.code, sqlc.narg('expires_at')::TIMESTAMPTZ
+FROM plans p
+WHERE p.code = sqlc.arg('plan_code')
+ON CONFLICT (user_id) DO UPDATE
+SET plan_code = EXCLUDED.plan_code, expires_at = EXCLUDED.expires_at, updated_at = CURRENT_TIMESTAMP;
+
+-- name: LockQuota :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg('key')::TEXT, 0));
+
+-- name: CountActiveListings :one
+SELECT COUNT(*)
+FROM cars
+WHERE owner_id = sqlc.arg('owner_id') AND deleted_at = 0 AND available
+  AND moderation_status NOT IN ('rejected', 'removed')
+  AND id IS DISTINCT FROM sqlc.narg('exclude_id');
+
+-- name: CountCarImages :one
+SELECT COUNT(*)
+FROM images
+WHERE car_id = sqlc.arg('car_id') AND kind = 'image' AND deleted_at = 0;
+
+-- name: GetCarOwnerId :one
+SELECT owner_id FROM cars WHERE id = sqlc.arg('id');
@@ -0,0 +1,37 @@
+-- name: CreateReport :one
+INSERT INTO reports (entity_type, entity_id, reporter_id, reason, details)
+VALUES (sqlc.arg('entity_type'), sqlc.arg('entity_id'), sqlc.arg('reporter_id'), sqlc.arg('reason'), sqlc.arg('details'))
+ON CONFLICT (entity_type, entity_id, reporter_id) WHERE status <> 'resolved' DO NOTHING
+RETURNING id, entity_type, entity_id, reporter_id, reason, details, status, outcome, note, created_at, resolved_at;
+
+-- name: CountOpenReporters :one
+SELECT COUNT(DISTINCT reporter_id)
+FROM reports
+WHERE entity_type = sqlc.arg('entity_type') AND entity_id = sqlc.arg('entity_id') AND status <> 'resolved';
+
+-- name: GetReport :one
+SELECT id, entity_type, entity_id, reporter_id, reason, details, status, outcome, note, created_at, resolved_at
+FROM reports
+WHERE id = sqlc.arg('id');
+
+-- name: ListReports :many
+SELECT id, entity_type, entity_id, reporter_id, reason, details, status, outcome, note, created_at, resolved_at
+FROM reports
+WHERE (sqlc.arg('status')::TEXT = '' OR status = sqlc.arg('status')::TEXT)
+    AND (sqlc.arg('entity_type')::TEXT = '' OR entity_type = sqlc.arg('entity_type')::TEXT)
+ORDER BY created_at ASC
+LIMIT sqlc.arg('limit')::INTEGER OFFSET sqlc.arg('offset')::INTEGER;
+
+-- name: TriageReport :execrows
+UPDATE reports
+SET status = 'triaged', note = sqlc.arg('note')
+WHERE id = sqlc.arg('id') AND status = 'open';
+
+-- name: ResolveReportsByEntity :execrows
+UPDATE reports
+SET status = 'resolved',
+    outcome = sqlc.arg('outcome'),
+    note = sqlc.arg('note'),
+    resolved_by = sqlc.arg('resolved_by'),
+    resolved_at = CURRENT_TIMESTAMP
+WHERE entity_type = sqlc.arg('entity_type') AND entity_id = sqlc.arg('entity_id') AND status <> 'resolved';
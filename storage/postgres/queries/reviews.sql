@@ -0,0 +1,62 @@
+-- name: CreateReview :one
+INSERT INTO reviews (reviewer_id, target_type, target_id, seller_id, rating, content)
+VALUES (sqlc.arg('reviewer_id'), sqlc.arg('target_type'), sqlc.arg('target_id'), sqlc.arg('seller_id'), sqlc.arg('rating'), sqlc.arg('content'))
+ON CONFLICT (reviewer_id, target_type, target_id) DO NOTHING
+RETURNING id, reviewer_id, target_type, target_id, seller_id, rating, content, created_at, updated_at;
+
+-- name: GetReviewById :one
+SELECT id, reviewer_id, target_type, target_id, seller_id, rating, content, created_at, updated_at
+FROM reviews
+WHERE id = sqlc.arg('id');
+
+-- name: UpdateReview :one
+UPDATE reviews
+SET rating = sqlc.arg('rating'), content = sqlc.arg('content'), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+RETURNING id, reviewer_id, target_type, target_id, seller_id, rating, content, created_at, updated_at;
+
+-- name: DeleteReview :exec
+DELETE FROM reviews
+WHERE id = sqlc.arg('id');
+
+-- name: ListReviews :many
+SELECT id, reviewer_id, target_type, target_id, seller_id, rating, content, created_at, updated_at
+FROM reviews
+WHERE target_type = sqlc.arg('target_type') AND target_id = sqlc.arg('target_id') AND moderation_status = 'approved'
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit')::INTEGER OFFSET sqlc.arg('offset')::INTEGER;
+
+-- name: HasExchangedMessages :one
+SELECT EXISTS (
+    SELECT 1 FROM messages WHERE sender_id = sqlc.arg('user_id') AND recipient_id = sqlc.arg('seller_id')
+) AND EXISTS (
+    SELECT 1 FROM messages WHERE sender_id = sqlc.arg('seller_id') AND recipient_id = sqlc.arg('user_id')
+) AS exchanged;
+
+-- name: RefreshCarRating :exec
+UPDATE cars
+SET rating_sum = r.total, rating_count = r.count
+FROM (
+    SELECT COALESCE(SUM(rating), 0)::INTEGER AS total, COUNT(*)::INTEGER AS count
+    FROM reviews
+    WHERE target_type = 'car' AND target_id = sqlc.arg('car_id') AND moderation_status = 'approved'
+) r
+WHERE cars.id = sqlc.arg('car_id');
+
+-- name: RefreshSellerRating :exec
+INSERT INTO seller_ratings (seller_id, rating_sum, rating_count, updated_at)
+SELECT sqlc.arg('seller_id')::UUID, COALESCE(SUM(rating), 0)::INTEGER, COUNT(*)::INTEGER, CURRENT_TIMESTAMP
+FROM reviews
+WHERE target_type = 'seller' AND target_id = sqlc.arg('seller_id')::UUID AND moderation_status = 'approved'
+ON CONFLICT (seller_id) DO UPDATE
+SET rating_sum = EXCLUDED.rating_sum, rating_count = EXCLUDED.rating_count, updated_at = EXCLUDED.updated_at;
+
+-- name: GetCarRating :one
+SELECT rating_sum, rating_count
+FROM cars
+WHERE id = sqlc.arg('id') AND deleted_at = 0;
+
+-- name: GetSellerRating :one
+SELECT rating_sum, rating_count
+FROM seller_ratings
+WHERE seller_id = sqlc.arg('seller_id');
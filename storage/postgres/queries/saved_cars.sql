@@ -9,7 +9,13 @@ RETURNING id, user_id, car_id, created_at, updated_at;
 -- name: GetSavedCarsByUser :many
 SELECT id, user_id, car_id, created_at, updated_at
 FROM saved_cars
-WHERE user_id = sqlc.arg('user_id');
+WHERE user_id = sqlc.arg('user_id')
+    AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0);
+
+-- name: GetSavedCarUsersByCarId :many
+SELECT DISTINCT user_id
+FROM saved_cars
+WHERE car_id = sqlc.arg('car_id');
 
 -- name: DeleteSavedCar :exec
 DELETE FROM saved_cars WHERE id = sqlc.arg('id');
@@ -17,6 +23,12 @@ DELETE FROM saved_cars WHERE id = sqlc.arg('id');
 -- name: DeleteSavedCarsByCarId :exec
 DELETE FROM saved_cars WHERE car_id = sqlc.arg('car_id');
 
+-- name: PurgeSavedCarsOfDeletedCars :execrows
+DELETE FROM saved_cars
+WHERE car_id IN (
+    SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < sqlc.arg('cutoff')::BIGINT
+);
+
 -- name: CheckSavedCarOwnership :one
 SELECT EXISTS (
     SELECT 1 FROM saved_cars
@@ -0,0 +1,61 @@
+-- name: LogSearchQuery :exec
+INSERT INTO search_queries (query, results, searcher)
+VALUES (sqlc.arg('query'), sqlc.arg('results'), sqlc.arg('searcher'));
+
+-- name: ClearSearchSuggestions :exec
+DELETE FROM search_suggestions;
+
+-- name: RebuildSearchSuggestions :execrows
+INSERT INTO search_suggestions (term_key, term, kind, weight)
+SELECT LOWER(s.term), MIN(s.term), (ARRAY_AGG(s.kind ORDER BY s.rank))[1], SUM(s.weight)::BIGINT
+FROM (
+    SELECT MIN(make) AS term, 'make' AS kind, 1 AS rank, COUNT(*) AS weight
+    FROM cars
+    WHERE deleted_at = 0 AND moderation_status = 'approved' AND make <> ''
+    GROUP BY LOWER(make)
+    UNION ALL
+    SELECT MIN(make || ' ' || model), 'model', 2, COUNT(*)
+    FROM cars
+    WHERE deleted_at = 0 AND moderation_status = 'approved' AND make <> '' AND model <> ''
+    GROUP BY LOWER(make || ' ' || model)
+    UNION ALL
+    SELECT MIN(location), 'location', 3, COUNT(*)
+    FROM cars
+    WHERE deleted_at = 0 AND moderation_status = 'approved' AND location <> ''
+    GROUP BY LOWER(location)
+    UNION ALL
+    SELECT query, 'query', 4, COUNT(*)
+    FROM search_queries
+    WHERE created_at >= sqlc.arg('since') AND results > 0 AND searcher <> ''
+    GROUP BY query
+    HAVING COUNT(DISTINCT searcher) >= sqlc.arg('min_count')::INTEGER
+) s
+GROUP BY LOWER(s.term);
+
+-- name: SuggestSearch :many
+SELECT term, kind
+FROM search_suggestions
+WHERE term_key LIKE sqlc.arg('prefix')::TEXT || '%'
+ORDER BY weight DESC, term_key
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: GetTrendingSearches :many
+WITH terms AS (
+    SELECT mk.name_key AS term_key, mk.name AS term
+    FROM car_makes mk
+    UNION
+    SELECT mk.name_key || md.name_key, mk.name || ' ' || md.name
+    FROM car_models md
+    JOIN car_makes mk ON mk.id = md.make_id
+)
+SELECT t.term AS query, COUNT(*) AS searches
+FROM search_queries q
+JOIN terms t ON t.term_key = regexp_replace(q.query, '[^[:alnum:]]+', '', 'g')
+WHERE q.created_at >= sqlc.arg('since') AND q.searcher <> ''
+GROUP BY t.term
+HAVING COUNT(DISTINCT q.searcher) >= sqlc.arg('min_searchers')::INTEGER
+ORDER BY searches DESC, t.term
+LIMIT sqlc.arg('limit')::INTEGER;
+
+-- name: PurgeSearchQueries :execrows
+DELETE FROM search_queries WHERE created_at < sqlc.arg('cutoff');
@@ -126,7 +126,9 @@ func (q *Queries) CreateCar(ctx context.Context, arg CreateCarParams) (CreateCar
 }
 
 const deleteCar = `-- name: DeleteCar :exec
-DELETE FROM cars WHERE id = $1
+UPDATE cars
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) DeleteCar(ctx context.Context, id pgtype.UUID) error {
@@ -138,40 +140,50 @@ const getCarById = `-- name: GetCarById :one
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.moderation_status, c.organization_id,
+    c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
-WHERE c.id = $1
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
+WHERE c.id = $1 AND c.deleted_at = 0
 GROUP BY c.id
 `
 
 type GetCarByIdRow struct {
-	ID           string             `json:"id"`
-	Type         string             `json:"type"`
-	Make         string             `json:"make"`
-	Model        string             `json:"model"`
-	Year         int32              `json:"year"`
-	Color        string             `json:"color"`
-	Mileage      int32              `json:"mileage"`
-	Price        interface{}        `json:"price"`
-	Description  zero.String        `json:"description"`
-	Available    pgtype.Bool        `json:"available"`
-	OwnerID      string             `json:"owner_id"`
-	Location     string             `json:"location"`
-	ReviewsCount pgtype.Int4        `json:"reviews_count"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	Images       []byte             `json:"images"`
+	ID               string             `json:"id"`
+	Type             string             `json:"type"`
+	Make             string             `json:"make"`
+	Model            string             `json:"model"`
+	Year             int32              `json:"year"`
+	Color            string             `json:"color"`
+	Mileage          int32              `json:"mileage"`
+	Price            interface{}        `json:"price"`
+	Description      zero.String        `json:"description"`
+	Available        pgtype.Bool        `json:"available"`
+	OwnerID          string             `json:"owner_id"`
+	Location         string             `json:"location"`
+	ReviewsCount     pgtype.Int4        `json:"reviews_count"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ModerationStatus string             `json:"moderation_status"`
+	OrganizationID   pgtype.UUID        `json:"organization_id"`
+	RatingSum        int32              `json:"rating_sum"`
+	RatingCount      int32              `json:"rating_count"`
+	Images           []byte             `json:"images"`
 }
 
 func (q *Queries) GetCarById(ctx context.Context, id pgtype.UUID) (GetCarByIdRow, error) {
@@ -193,15 +205,144 @@ func (q *Queries) GetCarById(ctx context.Context, id pgtype.UUID) (GetCarByIdRow
 		&i.ReviewsCount,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ModerationStatus,
+		&i.OrganizationID,
+		&i.RatingSum,
+		&i.RatingCount,
 		&i.Images,
 	)
 	return i, err
 }
 
+const getPriceComparables = `-- name: GetPriceComparables :one
+SELECT
+    COUNT(*) AS comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS low_price,
+    COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS median_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY price), 0)::FLOAT8 AS high_price
+FROM cars
+WHERE deleted_at = 0
+    AND moderation_status = 'approved'
+    AND LOWER(make) = LOWER($1::TEXT)
+    AND LOWER(model) = LOWER($2::TEXT)
+    AND year BETWEEN $3::INTEGER AND $4::INTEGER
+    AND mileage BETWEEN $5::INTEGER AND $6::INTEGER
+    AND ($7::TEXT = '' OR LOWER(location) = LOWER($7::TEXT))
+    AND ($8::UUID IS NULL OR id <> $8::UUID)
+`
+
+type GetPriceComparablesParams struct {
+	Make       zero.String `json:"make"`
+	Model      zero.String `json:"model"`
+	MinYear    pgtype.Int4 `json:"min_year"`
+	MaxYear    pgtype.Int4 `json:"max_year"`
+	MinMileage pgtype.Int4 `json:"min_mileage"`
+	MaxMileage pgtype.Int4 `json:"max_mileage"`
+	Location   zero.String `json:"location"`
+	ExcludeID  pgtype.UUID `json:"exclude_id"`
+}
+
+type GetPriceComparablesRow struct {
+	Comparables int64   `json:"comparables"`
+	LowPrice    float64 `json:"low_price"`
+	MedianPrice float64 `json:"median_price"`
+	HighPrice   float64 `json:"high_price"`
+}
+
+func (q *Queries) GetPriceComparables(ctx context.Context, arg GetPriceComparablesParams) (GetPriceComparablesRow, error) {
+	row := q.db.QueryRow(ctx, getPriceComparables, arg.Make, arg.Model, arg.MinYear, arg.MaxYear, arg.MinMileage, arg.MaxMileage, arg.Location, arg.ExcludeID)
+	var i GetPriceComparablesRow
+	err := row.Scan(
+		&i.Comparables,
+		&i.LowPrice,
+		&i.MedianPrice,
+		&i.HighPrice,
+	)
+	return i, err
+}
+
+const getPriceComparablesBatch = `-- name: GetPriceComparablesBatch :many
+SELECT
+    t.id AS car_id,
+    COUNT(c.id) FILTER (WHERE LOWER(c.location) = LOWER(t.location)) AS local_comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY c.price) FILTER (WHERE LOWER(c.location) = LOWER(t.location)), 0)::FLOAT8 AS local_low_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY c.price) FILTER (WHERE LOWER(c.location) = LOWER(t.location)), 0)::FLOAT8 AS local_high_price,
+    COUNT(c.id) AS comparables,
+    COALESCE(percentile_cont(0.25) WITHIN GROUP (ORDER BY c.price), 0)::FLOAT8 AS low_price,
+    COALESCE(percentile_cont(0.75) WITHIN GROUP (ORDER BY c.price), 0)::FLOAT8 AS high_price
+FROM unnest(
+    $1::UUID[],
+    $2::TEXT[],
+    $3::TEXT[],
+    $4::INTEGER[],
+    $5::INTEGER[],
+    $6::INTEGER[],
+    $7::INTEGER[],
+    $8::TEXT[]
+) AS t(id, make, model, min_year, max_year, min_mileage, max_mileage, location)
+LEFT JOIN cars c ON c.deleted_at = 0
+    AND c.moderation_status = 'approved'
+    AND LOWER(c.make) = LOWER(t.make)
+    AND LOWER(c.model) = LOWER(t.model)
+    AND c.year BETWEEN t.min_year AND t.max_year
+    AND c.mileage BETWEEN t.min_mileage AND t.max_mileage
+    AND c.id <> t.id
+GROUP BY t.id
+`
+
+type GetPriceComparablesBatchParams struct {
+	Ids         []pgtype.UUID `json:"ids"`
+	Makes       []string      `json:"makes"`
+	Models      []string      `json:"models"`
+	MinYears    []int32       `json:"min_years"`
+	MaxYears    []int32       `json:"max_years"`
+	MinMileages []int32       `json:"min_mileages"`
+	MaxMileages []int32       `json:"max_mileages"`
+	Locations   []string      `json:"locations"`
+}
+
+type GetPriceComparablesBatchRow struct {
+	CarID            string  `json:"car_id"`
+	LocalComparables int64   `json:"local_comparables"`
+	LocalLowPrice    float64 `json:"local_low_price"`
+	LocalHighPrice   float64 `json:"local_high_price"`
+	Comparables      int64   `json:"comparables"`
+	LowPrice         float64 `json:"low_price"`
+	HighPrice        float64 `json:"high_price"`
+}
+
+func (q *Queries) GetPriceComparablesBatch(ctx context.Context, arg GetPriceComparablesBatchParams) ([]GetPriceComparablesBatchRow, error) {
+	rows, err := q.db.Query(ctx, getPriceComparablesBatch, arg.Ids, arg.Makes, arg.Models, arg.MinYears, arg.MaxYears, arg.MinMileages, arg.MaxMileages, arg.Locations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPriceComparablesBatchRow
+	for rows.Next() {
+		var i GetPriceComparablesBatchRow
+		if err := rows.Scan(
+			&i.CarID,
+			&i.LocalComparables,
+			&i.LocalLowPrice,
+			&i.LocalHighPrice,
+			&i.Comparables,
+			&i.LowPrice,
+			&i.HighPrice,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const incrementCarReviewCount = `-- name: IncrementCarReviewCount :exec
 UPDATE cars
 SET reviews_count = reviews_count + 1
-WHERE id = $1
+WHERE id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) IncrementCarReviewCount(ctx context.Context, id pgtype.UUID) error {
@@ -213,21 +354,28 @@ const listCars = `-- name: ListCars :many
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
 WHERE 
-    ($1::TEXT IS NULL OR c.type = $1::TEXT)
+    c.deleted_at = 0
+    AND c.moderation_status = 'approved'
+    AND ($1::TEXT IS NULL OR c.type = $1::TEXT)
     AND ($2::TEXT IS NULL OR c.location = $2::TEXT)
     -- Add price range filters
     AND ($3::DECIMAL(10,2) IS NULL OR c.price >= $3::DECIMAL(10,2))
@@ -275,6 +423,8 @@ type ListCarsRow struct {
 	ReviewsCount pgtype.Int4        `json:"reviews_count"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	RatingSum    int32              `json:"rating_sum"`
+	RatingCount  int32              `json:"rating_count"`
 	Images       []byte             `json:"images"`
 }
 
@@ -312,6 +462,8 @@ func (q *Queries) ListCars(ctx context.Context, arg ListCarsParams) ([]ListCarsR
 			&i.ReviewsCount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RatingSum,
+			&i.RatingCount,
 			&i.Images,
 		); err != nil {
 			return nil, err
@@ -324,27 +476,61 @@ func (q *Queries) ListCars(ctx context.Context, arg ListCarsParams) ([]ListCarsR
 	return items, nil
 }
 
+const purgeDeletedCars = `-- name: PurgeDeletedCars :execrows
+DELETE FROM cars
+WHERE deleted_at <> 0 AND deleted_at < $1::BIGINT
+`
+
+func (q *Queries) PurgeDeletedCars(ctx context.Context, cutoff pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedCars, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCar = `-- name: RestoreCar :execrows
+UPDATE cars
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at <> 0
+`
+
+func (q *Queries) RestoreCar(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreCar, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const searchCar = `-- name: SearchCar :many
 SELECT 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
-    c.created_at, c.updated_at,
+    c.created_at, c.updated_at, c.rating_sum, c.rating_count,
     COALESCE(
         json_agg(
             jsonb_build_object(
                 'id', i.id,
                 'car_id', i.car_id,
                 'filename', i.filename,
-                'uploaded_at', i.uploaded_at
-            )
+                'uploaded_at', i.uploaded_at,
+                'variants', i.variants,
+                'position', i.position,
+                'is_cover', i.is_cover,
+                'width', i.width,
+                'height', i.height
+            ) ORDER BY i.position, i.uploaded_at
         ) FILTER (WHERE i.deleted_at = 0), '[]'
     ) AS images
 FROM cars c
-LEFT JOIN images i ON c.id = i.car_id
+LEFT JOIN images i ON c.id = i.car_id AND i.kind = 'image'
 WHERE 
+    c.deleted_at = 0 AND c.moderation_status = 'approved' AND (
     COALESCE($1, '') = '' OR 
     c.make ILIKE '%' || $1 || '%' OR 
     c.model ILIKE '%' || $1 || '%'
+    )
 GROUP BY 
     c.id, c.type, c.make, c.model, c.year, c.color, c.mileage, c.price, 
     c.description, c.available, c.owner_id, c.location, c.reviews_count, 
@@ -375,6 +561,8 @@ type SearchCarRow struct {
 	ReviewsCount pgtype.Int4        `json:"reviews_count"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	RatingSum    int32              `json:"rating_sum"`
+	RatingCount  int32              `json:"rating_count"`
 	Images       []byte             `json:"images"`
 }
 
@@ -403,6 +591,8 @@ func (q *Queries) SearchCar(ctx context.Context, arg SearchCarParams) ([]SearchC
 			&i.ReviewsCount,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RatingSum,
+			&i.RatingCount,
 			&i.Images,
 		); err != nil {
 			return nil, err
@@ -421,7 +611,7 @@ SET type = $1, make = $2, model = $3, year = $4,
     color = $5, mileage = $6, price = $7,
     description = $8, available = $9, location = $10, 
     updated_at = CURRENT_TIMESTAMP
-WHERE id = $11
+WHERE id = $11 AND deleted_at = 0
 `
 
 type UpdateCarParams struct {
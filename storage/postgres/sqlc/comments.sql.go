@@ -31,24 +31,57 @@ func (q *Queries) CheckCommentOwnership(ctx context.Context, arg CheckCommentOwn
 	return is_owner, err
 }
 
+const countCarComments = `-- name: CountCarComments :one
+WITH RECURSIVE thread AS (
+    SELECT id FROM comments
+    WHERE car_id = $1 AND parent_id IS NULL AND deleted_at = 0 AND moderation_status = 'approved'
+    UNION ALL
+    SELECT cm.id FROM comments cm
+    JOIN thread t ON cm.parent_id = t.id
+    WHERE cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+)
+SELECT COUNT(*) FROM thread
+`
+
+func (q *Queries) CountCarComments(ctx context.Context, carID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCarComments, carID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createComment = `-- name: CreateComment :one
-INSERT INTO comments (
-    "user_id",
-    "car_id",
-    "content"
-) VALUES (
-    $1,
-    $2,
-    $3
+WITH inserted AS (
+    INSERT INTO comments (
+        "user_id",
+        "car_id",
+        "content",
+        "parent_id",
+        "depth"
+    ) VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        COALESCE((SELECT depth + 1 FROM comments WHERE id = $4), 0)
+    )
+    RETURNING id, user_id, car_id, content, created_at, updated_at, parent_id, depth
 )
-RETURNING 
-    id, user_id, car_id, content, created_at, updated_at
+SELECT
+    i.id, i.user_id, i.car_id, i.content, i.created_at, i.updated_at, i.parent_id, i.depth,
+    (i.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = i.user_id
+    ))::BOOLEAN AS is_seller
+FROM inserted i
+JOIN cars c ON c.id = i.car_id
 `
 
 type CreateCommentParams struct {
-	UserID  pgtype.UUID `json:"user_id"`
-	CarID   pgtype.UUID `json:"car_id"`
-	Content zero.String `json:"content"`
+	UserID   pgtype.UUID `json:"user_id"`
+	CarID    pgtype.UUID `json:"car_id"`
+	Content  zero.String `json:"content"`
+	ParentID pgtype.UUID `json:"parent_id"`
 }
 
 type CreateCommentRow struct {
@@ -58,10 +91,13 @@ type CreateCommentRow struct {
 	Content   string             `json:"content"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Depth     int32              `json:"depth"`
+	IsSeller  bool               `json:"is_seller"`
 }
 
 func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (CreateCommentRow, error) {
-	row := q.db.QueryRow(ctx, createComment, arg.UserID, arg.CarID, arg.Content)
+	row := q.db.QueryRow(ctx, createComment, arg.UserID, arg.CarID, arg.Content, arg.ParentID)
 	var i CreateCommentRow
 	err := row.Scan(
 		&i.ID,
@@ -70,13 +106,23 @@ func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (C
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ParentID,
+		&i.Depth,
+		&i.IsSeller,
 	)
 	return i, err
 }
 
 const deleteComment = `-- name: DeleteComment :exec
-DELETE FROM comments 
-WHERE id = $1
+WITH RECURSIVE thread AS (
+    SELECT id FROM comments WHERE id = $1 AND deleted_at = 0
+    UNION ALL
+    SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+    WHERE c.deleted_at = 0
+)
+UPDATE comments
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id IN (SELECT id FROM thread)
 `
 
 func (q *Queries) DeleteComment(ctx context.Context, id pgtype.UUID) error {
@@ -84,9 +130,28 @@ func (q *Queries) DeleteComment(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const deleteCommentReaction = `-- name: DeleteCommentReaction :execrows
+DELETE FROM comment_reactions
+WHERE comment_id = $1 AND user_id = $2
+`
+
+type DeleteCommentReactionParams struct {
+	CommentID pgtype.UUID `json:"comment_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteCommentReaction(ctx context.Context, arg DeleteCommentReactionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCommentReaction, arg.CommentID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteCommentsByCarId = `-- name: DeleteCommentsByCarId :exec
-DELETE FROM comments 
-WHERE car_id = $1
+UPDATE comments
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE car_id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) DeleteCommentsByCarId(ctx context.Context, carID pgtype.UUID) error {
@@ -94,31 +159,353 @@ func (q *Queries) DeleteCommentsByCarId(ctx context.Context, carID pgtype.UUID)
 	return err
 }
 
-const getCommentsByCar = `-- name: GetCommentsByCar :many
+const getCommentById = `-- name: GetCommentById :one
 SELECT 
     id, user_id, car_id, content, created_at, updated_at
 FROM comments
-WHERE car_id = $1 AND deleted_at = 0
+WHERE id = $1 AND deleted_at = 0
+`
+
+type GetCommentByIdRow struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	CarID     string             `json:"car_id"`
+	Content   string             `json:"content"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+func (q *Queries) GetCommentById(ctx context.Context, id pgtype.UUID) (GetCommentByIdRow, error) {
+	row := q.db.QueryRow(ctx, getCommentById, id)
+	var i GetCommentByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CarID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCommentReactionCounts = `-- name: GetCommentReactionCounts :many
+SELECT comment_id, reaction, COUNT(*) AS count
+FROM comment_reactions
+WHERE comment_id = ANY($1::UUID[])
+GROUP BY comment_id, reaction
+`
+
+type GetCommentReactionCountsRow struct {
+	CommentID string `json:"comment_id"`
+	Reaction  string `json:"reaction"`
+	Count     int64  `json:"count"`
+}
+
+func (q *Queries) GetCommentReactionCounts(ctx context.Context, commentIds []pgtype.UUID) ([]GetCommentReactionCountsRow, error) {
+	rows, err := q.db.Query(ctx, getCommentReactionCounts, commentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentReactionCountsRow
+	for rows.Next() {
+		var i GetCommentReactionCountsRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.Reaction,
+			&i.Count,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentReplies = `-- name: GetCommentReplies :many
+WITH RECURSIVE thread AS (
+    SELECT cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+        cm.parent_id AS root_id
+    FROM comments cm
+    WHERE cm.parent_id = ANY($1::UUID[]) AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    UNION ALL
+    SELECT cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+        t.root_id
+    FROM comments cm
+    JOIN thread t ON cm.parent_id = t.id
+    WHERE cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+), ranked AS (
+    SELECT thread.*, ROW_NUMBER() OVER (PARTITION BY root_id ORDER BY created_at, id) AS n
+    FROM thread
+)
+SELECT 
+    t.id, t.user_id, t.car_id, t.content, t.created_at, t.updated_at, t.parent_id, t.depth, t.like_count,
+    (t.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = t.user_id
+    ))::BOOLEAN AS is_seller
+FROM ranked t
+JOIN cars c ON c.id = t.car_id
+WHERE t.n <= $2::INTEGER
+ORDER BY t.created_at, t.id
+`
+
+type GetCommentRepliesParams struct {
+	RootIds    []pgtype.UUID `json:"root_ids"`
+	ReplyLimit pgtype.Int4   `json:"reply_limit"`
+}
+
+type GetCommentRepliesRow struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	CarID     string             `json:"car_id"`
+	Content   string             `json:"content"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Depth     int32              `json:"depth"`
+	LikeCount int32              `json:"like_count"`
+	IsSeller  bool               `json:"is_seller"`
+}
+
+func (q *Queries) GetCommentReplies(ctx context.Context, arg GetCommentRepliesParams) ([]GetCommentRepliesRow, error) {
+	rows, err := q.db.Query(ctx, getCommentReplies, arg.RootIds, arg.ReplyLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentRepliesRow
+	for rows.Next() {
+		var i GetCommentRepliesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CarID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ParentID,
+			&i.Depth,
+			&i.LikeCount,
+			&i.IsSeller,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentThreadInfo = `-- name: GetCommentThreadInfo :one
+SELECT car_id, depth, moderation_status
+FROM comments
+WHERE id = $1 AND deleted_at = 0
+`
+
+type GetCommentThreadInfoRow struct {
+	CarID            string `json:"car_id"`
+	Depth            int32  `json:"depth"`
+	ModerationStatus string `json:"moderation_status"`
+}
+
+func (q *Queries) GetCommentThreadInfo(ctx context.Context, id pgtype.UUID) (GetCommentThreadInfoRow, error) {
+	row := q.db.QueryRow(ctx, getCommentThreadInfo, id)
+	var i GetCommentThreadInfoRow
+	err := row.Scan(
+		&i.CarID,
+		&i.Depth,
+		&i.ModerationStatus,
+	)
+	return i, err
+}
+
+const getCommentsByCarMostLiked = `-- name: GetCommentsByCarMostLiked :many
+SELECT 
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = $1 AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND ($2::UUID IS NULL
+        OR (cm.like_count, cm.created_at, cm.id) < ($3::INTEGER, $4::TIMESTAMPTZ, $2::UUID))
+ORDER BY cm.like_count DESC, cm.created_at DESC, cm.id DESC
+LIMIT $5::INTEGER
+`
+
+type GetCommentsByCarMostLikedParams struct {
+	CarID           pgtype.UUID        `json:"car_id"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	CursorLikes     pgtype.Int4        `json:"cursor_likes"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	Limit           pgtype.Int4        `json:"limit"`
+}
+
+type GetCommentsByCarMostLikedRow struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	CarID     string             `json:"car_id"`
+	Content   string             `json:"content"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Depth     int32              `json:"depth"`
+	LikeCount int32              `json:"like_count"`
+	IsSeller  bool               `json:"is_seller"`
+}
+
+func (q *Queries) GetCommentsByCarMostLiked(ctx context.Context, arg GetCommentsByCarMostLikedParams) ([]GetCommentsByCarMostLikedRow, error) {
+	rows, err := q.db.Query(ctx, getCommentsByCarMostLiked, arg.CarID, arg.CursorID, arg.CursorLikes, arg.CursorCreatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentsByCarMostLikedRow
+	for rows.Next() {
+		var i GetCommentsByCarMostLikedRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CarID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ParentID,
+			&i.Depth,
+			&i.LikeCount,
+			&i.IsSeller,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentsByCarNewest = `-- name: GetCommentsByCarNewest :many
+SELECT 
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = $1 AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND ($2::UUID IS NULL
+        OR (cm.created_at, cm.id) < ($3::TIMESTAMPTZ, $2::UUID))
+ORDER BY cm.created_at DESC, cm.id DESC
+LIMIT $4::INTEGER
+`
+
+type GetCommentsByCarNewestParams struct {
+	CarID           pgtype.UUID        `json:"car_id"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	Limit           pgtype.Int4        `json:"limit"`
+}
+
+type GetCommentsByCarNewestRow struct {
+	ID        string             `json:"id"`
+	UserID    string             `json:"user_id"`
+	CarID     string             `json:"car_id"`
+	Content   string             `json:"content"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Depth     int32              `json:"depth"`
+	LikeCount int32              `json:"like_count"`
+	IsSeller  bool               `json:"is_seller"`
+}
+
+func (q *Queries) GetCommentsByCarNewest(ctx context.Context, arg GetCommentsByCarNewestParams) ([]GetCommentsByCarNewestRow, error) {
+	rows, err := q.db.Query(ctx, getCommentsByCarNewest, arg.CarID, arg.CursorID, arg.CursorCreatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCommentsByCarNewestRow
+	for rows.Next() {
+		var i GetCommentsByCarNewestRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CarID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ParentID,
+			&i.Depth,
+			&i.LikeCount,
+			&i.IsSeller,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCommentsByCarOldest = `-- name: GetCommentsByCarOldest :many
+SELECT 
+    cm.id, cm.user_id, cm.car_id, cm.content, cm.created_at, cm.updated_at, cm.parent_id, cm.depth, cm.like_count,
+    (cm.user_id = c.owner_id OR EXISTS (
+        SELECT 1 FROM organization_members m
+        WHERE m.org_id = c.organization_id AND m.user_id = cm.user_id
+    ))::BOOLEAN AS is_seller
+FROM comments cm
+JOIN cars c ON c.id = cm.car_id
+WHERE cm.car_id = $1 AND cm.parent_id IS NULL AND cm.deleted_at = 0 AND cm.moderation_status = 'approved'
+    AND ($2::UUID IS NULL
+        OR (cm.created_at, cm.id) > ($3::TIMESTAMPTZ, $2::UUID))
+ORDER BY cm.created_at, cm.id
+LIMIT $4::INTEGER
 `
 
-type GetCommentsByCarRow struct {
+type GetCommentsByCarOldestParams struct {
+	CarID           pgtype.UUID        `json:"car_id"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	Limit           pgtype.Int4        `json:"limit"`
+}
+
+type GetCommentsByCarOldestRow struct {
 	ID        string             `json:"id"`
 	UserID    string             `json:"user_id"`
 	CarID     string             `json:"car_id"`
 	Content   string             `json:"content"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	Depth     int32              `json:"depth"`
+	LikeCount int32              `json:"like_count"`
+	IsSeller  bool               `json:"is_seller"`
 }
 
-func (q *Queries) GetCommentsByCar(ctx context.Context, carID pgtype.UUID) ([]GetCommentsByCarRow, error) {
-	rows, err := q.db.Query(ctx, getCommentsByCar, carID)
+func (q *Queries) GetCommentsByCarOldest(ctx context.Context, arg GetCommentsByCarOldestParams) ([]GetCommentsByCarOldestRow, error) {
+	rows, err := q.db.Query(ctx, getCommentsByCarOldest, arg.CarID, arg.CursorID, arg.CursorCreatedAt, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetCommentsByCarRow
+	var items []GetCommentsByCarOldestRow
 	for rows.Next() {
-		var i GetCommentsByCarRow
+		var i GetCommentsByCarOldestRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.UserID,
@@ -126,6 +513,10 @@ func (q *Queries) GetCommentsByCar(ctx context.Context, carID pgtype.UUID) ([]Ge
 			&i.Content,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ParentID,
+			&i.Depth,
+			&i.LikeCount,
+			&i.IsSeller,
 		); err != nil {
 			return nil, err
 		}
@@ -137,12 +528,118 @@ func (q *Queries) GetCommentsByCar(ctx context.Context, carID pgtype.UUID) ([]Ge
 	return items, nil
 }
 
+const getUserCommentReactions = `-- name: GetUserCommentReactions :many
+SELECT comment_id, reaction
+FROM comment_reactions
+WHERE user_id = $1 AND comment_id = ANY($2::UUID[])
+`
+
+type GetUserCommentReactionsParams struct {
+	UserID     pgtype.UUID   `json:"user_id"`
+	CommentIds []pgtype.UUID `json:"comment_ids"`
+}
+
+type GetUserCommentReactionsRow struct {
+	CommentID string `json:"comment_id"`
+	Reaction  string `json:"reaction"`
+}
+
+func (q *Queries) GetUserCommentReactions(ctx context.Context, arg GetUserCommentReactionsParams) ([]GetUserCommentReactionsRow, error) {
+	rows, err := q.db.Query(ctx, getUserCommentReactions, arg.UserID, arg.CommentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserCommentReactionsRow
+	for rows.Next() {
+		var i GetUserCommentReactionsRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.Reaction,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedComments = `-- name: PurgeDeletedComments :execrows
+DELETE FROM comments
+WHERE (deleted_at <> 0 AND deleted_at < $1::BIGINT)
+    OR car_id IN (
+        SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < $1::BIGINT
+    )
+`
+
+func (q *Queries) PurgeDeletedComments(ctx context.Context, cutoff pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedComments, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const refreshCommentLikeCount = `-- name: RefreshCommentLikeCount :exec
+UPDATE comments
+SET like_count = (
+    SELECT COUNT(*) FROM comment_reactions
+    WHERE comment_id = $1 AND reaction = 'like'
+)
+WHERE id = $1
+`
+
+func (q *Queries) RefreshCommentLikeCount(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, refreshCommentLikeCount, id)
+	return err
+}
+
+const restoreComment = `-- name: RestoreComment :execrows
+WITH RECURSIVE target AS (
+    SELECT id, deleted_at FROM comments
+    WHERE id = $1 AND deleted_at <> 0
+        AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0)
+        AND (parent_id IS NULL OR parent_id IN (SELECT id FROM comments WHERE deleted_at = 0))
+), thread AS (
+    SELECT id FROM target
+    UNION ALL
+    SELECT c.id FROM comments c JOIN thread t ON c.parent_id = t.id
+    WHERE c.deleted_at = (SELECT deleted_at FROM target)
+)
+UPDATE comments
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id IN (SELECT id FROM thread)
+`
+
+func (q *Queries) RestoreComment(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreCommentsByCarId = `-- name: RestoreCommentsByCarId :exec
+UPDATE comments
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE car_id = $1 AND deleted_at <> 0
+    AND deleted_at = (SELECT deleted_at FROM cars WHERE id = $1)
+`
+
+func (q *Queries) RestoreCommentsByCarId(ctx context.Context, carID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, restoreCommentsByCarId, carID)
+	return err
+}
+
 const updateComment = `-- name: UpdateComment :exec
 UPDATE comments
 SET 
     content = $1, 
     updated_at = CURRENT_TIMESTAMP
-WHERE id = $2
+WHERE id = $2 AND deleted_at = 0
 `
 
 type UpdateCommentParams struct {
@@ -154,3 +651,21 @@ func (q *Queries) UpdateComment(ctx context.Context, arg UpdateCommentParams) er
 	_, err := q.db.Exec(ctx, updateComment, arg.Content, arg.ID)
 	return err
 }
+
+const upsertCommentReaction = `-- name: UpsertCommentReaction :exec
+INSERT INTO comment_reactions (comment_id, user_id, reaction)
+VALUES ($1, $2, $3)
+ON CONFLICT (comment_id, user_id) DO UPDATE
+SET reaction = EXCLUDED.reaction, created_at = CURRENT_TIMESTAMP
+`
+
+type UpsertCommentReactionParams struct {
+	CommentID pgtype.UUID `json:"comment_id"`
+	UserID    pgtype.UUID `json:"user_id"`
+	Reaction  string      `json:"reaction"`
+}
+
+func (q *Queries) UpsertCommentReaction(ctx context.Context, arg UpsertCommentReactionParams) error {
+	_, err := q.db.Exec(ctx, upsertCommentReaction, arg.CommentID, arg.UserID, arg.Reaction)
+	return err
+}
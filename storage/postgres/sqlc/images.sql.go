@@ -13,14 +13,26 @@ import (
 )
 
 const addImage = `-- name: AddImage :one
-INSERT INTO images (car_id, filename)
-VALUES ($1, $2)
-RETURNING id, car_id, filename, uploaded_at
+INSERT INTO images (car_id, filename, variants, width, height, size_bytes, checksum, phash, mime_type, position, is_cover)
+VALUES (
+    $1, $2, $3::TEXT[],
+    $4, $5, $6, $7, $8, $9,
+    COALESCE((SELECT MAX(position) + 1 FROM images WHERE car_id = $1 AND kind = 'image' AND deleted_at = 0), 0),
+    NOT EXISTS (SELECT 1 FROM images WHERE car_id = $1 AND is_cover AND deleted_at = 0)
+)
+RETURNING id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum
 `
 
 type AddImageParams struct {
-	CarID    pgtype.UUID `json:"car_id"`
-	Filename zero.String `json:"filename"`
+	CarID     pgtype.UUID `json:"car_id"`
+	Filename  zero.String `json:"filename"`
+	Variants  []string    `json:"variants"`
+	Width     pgtype.Int4 `json:"width"`
+	Height    pgtype.Int4 `json:"height"`
+	SizeBytes pgtype.Int8 `json:"size_bytes"`
+	Checksum  zero.String `json:"checksum"`
+	Phash     pgtype.Int8 `json:"phash"`
+	MimeType  zero.String `json:"mime_type"`
 }
 
 type AddImageRow struct {
@@ -28,22 +40,161 @@ type AddImageRow struct {
 	CarID      string             `json:"car_id"`
 	Filename   string             `json:"filename"`
 	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Position   int32              `json:"position"`
+	IsCover    bool               `json:"is_cover"`
+	Width      pgtype.Int4        `json:"width"`
+	Height     pgtype.Int4        `json:"height"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
 }
 
 func (q *Queries) AddImage(ctx context.Context, arg AddImageParams) (AddImageRow, error) {
-	row := q.db.QueryRow(ctx, addImage, arg.CarID, arg.Filename)
+	row := q.db.QueryRow(ctx, addImage,
+		arg.CarID,
+		arg.Filename,
+		arg.Variants,
+		arg.Width,
+		arg.Height,
+		arg.SizeBytes,
+		arg.Checksum,
+		arg.Phash,
+		arg.MimeType,
+	)
 	var i AddImageRow
 	err := row.Scan(
 		&i.ID,
 		&i.CarID,
 		&i.Filename,
 		&i.UploadedAt,
+		&i.Variants,
+		&i.Position,
+		&i.IsCover,
+		&i.Width,
+		&i.Height,
+		&i.SizeBytes,
+		&i.Checksum,
+	)
+	return i, err
+}
+
+const addMedia = `-- name: AddMedia :one
+INSERT INTO images (car_id, filename, variants, kind, mime_type, visibility, size_bytes, checksum, position)
+VALUES (
+    $1, $2, '{}', $3, $4, $5,
+    $6, $7,
+    COALESCE((SELECT MAX(position) + 1 FROM images WHERE car_id = $1 AND kind = $3 AND deleted_at = 0), 0)
+)
+RETURNING id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum
+`
+
+type AddMediaParams struct {
+	CarID      pgtype.UUID `json:"car_id"`
+	Filename   string      `json:"filename"`
+	Kind       string      `json:"kind"`
+	MimeType   zero.String `json:"mime_type"`
+	Visibility string      `json:"visibility"`
+	SizeBytes  pgtype.Int8 `json:"size_bytes"`
+	Checksum   zero.String `json:"checksum"`
+}
+
+type AddMediaRow struct {
+	ID         string             `json:"id"`
+	CarID      string             `json:"car_id"`
+	Filename   string             `json:"filename"`
+	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Kind       string             `json:"kind"`
+	MimeType   zero.String        `json:"mime_type"`
+	Visibility string             `json:"visibility"`
+	Position   int32              `json:"position"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
+}
+
+func (q *Queries) AddMedia(ctx context.Context, arg AddMediaParams) (AddMediaRow, error) {
+	row := q.db.QueryRow(ctx, addMedia, arg.CarID, arg.Filename, arg.Kind, arg.MimeType, arg.Visibility, arg.SizeBytes, arg.Checksum)
+	var i AddMediaRow
+	err := row.Scan(
+		&i.ID,
+		&i.CarID,
+		&i.Filename,
+		&i.UploadedAt,
+		&i.Variants,
+		&i.Kind,
+		&i.MimeType,
+		&i.Visibility,
+		&i.Position,
+		&i.SizeBytes,
+		&i.Checksum,
 	)
 	return i, err
 }
 
+const checkImageOwnership = `-- name: CheckImageOwnership :one
+SELECT EXISTS (
+    SELECT 1 FROM images i
+    JOIN cars c ON c.id = i.car_id
+    WHERE i.id = $1 AND c.owner_id = $2
+) AS is_owner
+`
+
+type CheckImageOwnershipParams struct {
+	ID      pgtype.UUID `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) CheckImageOwnership(ctx context.Context, arg CheckImageOwnershipParams) (bool, error) {
+	row := q.db.QueryRow(ctx, checkImageOwnership, arg.ID, arg.OwnerID)
+	var is_owner bool
+	err := row.Scan(&is_owner)
+	return is_owner, err
+}
+
+const clearCoverImage = `-- name: ClearCoverImage :exec
+UPDATE images SET is_cover = FALSE
+WHERE car_id = $1 AND is_cover
+`
+
+func (q *Queries) ClearCoverImage(ctx context.Context, carID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, clearCoverImage, carID)
+	return err
+}
+
+const countCarMedia = `-- name: CountCarMedia :one
+SELECT COUNT(*)
+FROM images
+WHERE car_id = $1 AND kind <> 'image' AND deleted_at = 0
+`
+
+func (q *Queries) CountCarMedia(ctx context.Context, carID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCarMedia, carID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createImageMatch = `-- name: CreateImageMatch :exec
+INSERT INTO image_matches (image_id, matched_image_id, distance)
+VALUES ($1, $2, $3)
+ON CONFLICT (image_id, matched_image_id) DO NOTHING
+`
+
+type CreateImageMatchParams struct {
+	ImageID        pgtype.UUID `json:"image_id"`
+	MatchedImageID pgtype.UUID `json:"matched_image_id"`
+	Distance       int32       `json:"distance"`
+}
+
+func (q *Queries) CreateImageMatch(ctx context.Context, arg CreateImageMatchParams) error {
+	_, err := q.db.Exec(ctx, createImageMatch, arg.ImageID, arg.MatchedImageID, arg.Distance)
+	return err
+}
+
 const deleteImage = `-- name: DeleteImage :exec
-DELETE FROM images WHERE id = $1
+UPDATE images
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, is_cover = FALSE
+WHERE id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) DeleteImage(ctx context.Context, id pgtype.UUID) error {
@@ -52,7 +203,9 @@ func (q *Queries) DeleteImage(ctx context.Context, id pgtype.UUID) error {
 }
 
 const deleteImagesByCarId = `-- name: DeleteImagesByCarId :exec
-DELETE FROM images WHERE car_id = $1
+UPDATE images
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, is_cover = FALSE
+WHERE car_id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) DeleteImagesByCarId(ctx context.Context, carID pgtype.UUID) error {
@@ -60,10 +213,108 @@ func (q *Queries) DeleteImagesByCarId(ctx context.Context, carID pgtype.UUID) er
 	return err
 }
 
+const ensureCoverImage = `-- name: EnsureCoverImage :exec
+UPDATE images SET is_cover = TRUE
+WHERE id = (
+    SELECT id FROM images
+    WHERE car_id = $1 AND kind = 'image' AND deleted_at = 0
+    ORDER BY position, uploaded_at
+    LIMIT 1
+) AND NOT EXISTS (SELECT 1 FROM images WHERE car_id = $1 AND is_cover AND deleted_at = 0)
+`
+
+func (q *Queries) EnsureCoverImage(ctx context.Context, carID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, ensureCoverImage, carID)
+	return err
+}
+
+const findSimilarImages = `-- name: FindSimilarImages :many
+SELECT i.id, i.car_id, c.owner_id, d.distance::INTEGER AS distance
+FROM images i
+JOIN cars c ON c.id = i.car_id
+CROSS JOIN LATERAL (
+    SELECT length(replace((i.phash # $1::BIGINT)::BIT(64)::TEXT, '0', '')) AS distance
+) d
+WHERE i.phash IS NOT NULL AND i.kind = 'image' AND i.deleted_at = 0
+    AND (i.phash_b0 = ANY($2::INTEGER[])
+        OR i.phash_b1 = ANY($3::INTEGER[])
+        OR i.phash_b2 = ANY($4::INTEGER[])
+        OR i.phash_b3 = ANY($5::INTEGER[]))
+    AND c.deleted_at = 0
+    AND c.owner_id <> $6
+    AND d.distance <= $7::INTEGER
+ORDER BY d.distance, i.uploaded_at
+LIMIT 10
+`
+
+type FindSimilarImagesParams struct {
+	Phash       pgtype.Int8 `json:"phash"`
+	Band0       []int32     `json:"band0"`
+	Band1       []int32     `json:"band1"`
+	Band2       []int32     `json:"band2"`
+	Band3       []int32     `json:"band3"`
+	OwnerID     pgtype.UUID `json:"owner_id"`
+	MaxDistance pgtype.Int4 `json:"max_distance"`
+}
+
+type FindSimilarImagesRow struct {
+	ID       string `json:"id"`
+	CarID    string `json:"car_id"`
+	OwnerID  string `json:"owner_id"`
+	Distance int32  `json:"distance"`
+}
+
+func (q *Queries) FindSimilarImages(ctx context.Context, arg FindSimilarImagesParams) ([]FindSimilarImagesRow, error) {
+	rows, err := q.db.Query(ctx, findSimilarImages, arg.Phash, arg.Band0, arg.Band1, arg.Band2, arg.Band3, arg.OwnerID, arg.MaxDistance)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindSimilarImagesRow
+	for rows.Next() {
+		var i FindSimilarImagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CarID,
+			&i.OwnerID,
+			&i.Distance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeletedImageCar = `-- name: GetDeletedImageCar :one
+SELECT i.car_id, c.owner_id
+FROM images i
+JOIN cars c ON c.id = i.car_id
+WHERE i.id = $1 AND i.kind = 'image' AND i.deleted_at <> 0 AND c.deleted_at = 0
+`
+
+type GetDeletedImageCarRow struct {
+	CarID   string `json:"car_id"`
+	OwnerID string `json:"owner_id"`
+}
+
+func (q *Queries) GetDeletedImageCar(ctx context.Context, id pgtype.UUID) (GetDeletedImageCarRow, error) {
+	row := q.db.QueryRow(ctx, getDeletedImageCar, id)
+	var i GetDeletedImageCarRow
+	err := row.Scan(
+		&i.CarID,
+		&i.OwnerID,
+	)
+	return i, err
+}
+
 const getImageById = `-- name: GetImageById :one
-SELECT id, car_id, filename, uploaded_at
+SELECT id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum
 FROM images
-WHERE id = $1
+WHERE id = $1 AND kind = 'image' AND deleted_at = 0
 `
 
 type GetImageByIdRow struct {
@@ -71,6 +322,13 @@ type GetImageByIdRow struct {
 	CarID      string             `json:"car_id"`
 	Filename   string             `json:"filename"`
 	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Position   int32              `json:"position"`
+	IsCover    bool               `json:"is_cover"`
+	Width      pgtype.Int4        `json:"width"`
+	Height     pgtype.Int4        `json:"height"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
 }
 
 func (q *Queries) GetImageById(ctx context.Context, id pgtype.UUID) (GetImageByIdRow, error) {
@@ -81,14 +339,22 @@ func (q *Queries) GetImageById(ctx context.Context, id pgtype.UUID) (GetImageByI
 		&i.CarID,
 		&i.Filename,
 		&i.UploadedAt,
+		&i.Variants,
+		&i.Position,
+		&i.IsCover,
+		&i.Width,
+		&i.Height,
+		&i.SizeBytes,
+		&i.Checksum,
 	)
 	return i, err
 }
 
 const getImagesByCar = `-- name: GetImagesByCar :many
-SELECT id, car_id, filename, uploaded_at
+SELECT id, car_id, filename, uploaded_at, variants, position, is_cover, width, height, size_bytes, checksum
 FROM images
-WHERE car_id = $1
+WHERE car_id = $1 AND kind = 'image' AND deleted_at = 0
+ORDER BY position, uploaded_at
 `
 
 type GetImagesByCarRow struct {
@@ -96,6 +362,13 @@ type GetImagesByCarRow struct {
 	CarID      string             `json:"car_id"`
 	Filename   string             `json:"filename"`
 	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Position   int32              `json:"position"`
+	IsCover    bool               `json:"is_cover"`
+	Width      pgtype.Int4        `json:"width"`
+	Height     pgtype.Int4        `json:"height"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
 }
 
 func (q *Queries) GetImagesByCar(ctx context.Context, carID pgtype.UUID) ([]GetImagesByCarRow, error) {
@@ -112,6 +385,13 @@ func (q *Queries) GetImagesByCar(ctx context.Context, carID pgtype.UUID) ([]GetI
 			&i.CarID,
 			&i.Filename,
 			&i.UploadedAt,
+			&i.Variants,
+			&i.Position,
+			&i.IsCover,
+			&i.Width,
+			&i.Height,
+			&i.SizeBytes,
+			&i.Checksum,
 		); err != nil {
 			return nil, err
 		}
@@ -122,3 +402,287 @@ func (q *Queries) GetImagesByCar(ctx context.Context, carID pgtype.UUID) ([]GetI
 	}
 	return items, nil
 }
+
+const getMediaById = `-- name: GetMediaById :one
+SELECT id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum
+FROM images
+WHERE id = $1 AND deleted_at = 0
+`
+
+type GetMediaByIdRow struct {
+	ID         string             `json:"id"`
+	CarID      string             `json:"car_id"`
+	Filename   string             `json:"filename"`
+	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Kind       string             `json:"kind"`
+	MimeType   zero.String        `json:"mime_type"`
+	Visibility string             `json:"visibility"`
+	Position   int32              `json:"position"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
+}
+
+func (q *Queries) GetMediaById(ctx context.Context, id pgtype.UUID) (GetMediaByIdRow, error) {
+	row := q.db.QueryRow(ctx, getMediaById, id)
+	var i GetMediaByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.CarID,
+		&i.Filename,
+		&i.UploadedAt,
+		&i.Variants,
+		&i.Kind,
+		&i.MimeType,
+		&i.Visibility,
+		&i.Position,
+		&i.SizeBytes,
+		&i.Checksum,
+	)
+	return i, err
+}
+
+const listCarImageFiles = `-- name: ListCarImageFiles :many
+SELECT filename, variants, deleted_at, uploaded_at
+FROM images
+WHERE car_id = $1
+`
+
+type ListCarImageFilesRow struct {
+	Filename   string             `json:"filename"`
+	Variants   []string           `json:"variants"`
+	DeletedAt  int64              `json:"deleted_at"`
+	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+}
+
+func (q *Queries) ListCarImageFiles(ctx context.Context, carID pgtype.UUID) ([]ListCarImageFilesRow, error) {
+	rows, err := q.db.Query(ctx, listCarImageFiles, carID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCarImageFilesRow
+	for rows.Next() {
+		var i ListCarImageFilesRow
+		if err := rows.Scan(
+			&i.Filename,
+			&i.Variants,
+			&i.DeletedAt,
+			&i.UploadedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCarMedia = `-- name: ListCarMedia :many
+SELECT id, car_id, filename, uploaded_at, variants, kind, mime_type, visibility, position, size_bytes, checksum
+FROM images
+WHERE car_id = $1 AND deleted_at = 0
+    AND ($2::TEXT IS NULL OR kind = $2::TEXT)
+    AND (visibility = 'public' OR $3::BOOLEAN)
+ORDER BY kind, position, uploaded_at
+`
+
+type ListCarMediaParams struct {
+	CarID          pgtype.UUID `json:"car_id"`
+	Kind           zero.String `json:"kind"`
+	IncludePrivate bool        `json:"include_private"`
+}
+
+type ListCarMediaRow struct {
+	ID         string             `json:"id"`
+	CarID      string             `json:"car_id"`
+	Filename   string             `json:"filename"`
+	UploadedAt pgtype.Timestamptz `json:"uploaded_at"`
+	Variants   []string           `json:"variants"`
+	Kind       string             `json:"kind"`
+	MimeType   zero.String        `json:"mime_type"`
+	Visibility string             `json:"visibility"`
+	Position   int32              `json:"position"`
+	SizeBytes  pgtype.Int8        `json:"size_bytes"`
+	Checksum   zero.String        `json:"checksum"`
+}
+
+func (q *Queries) ListCarMedia(ctx context.Context, arg ListCarMediaParams) ([]ListCarMediaRow, error) {
+	rows, err := q.db.Query(ctx, listCarMedia, arg.CarID, arg.Kind, arg.IncludePrivate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCarMediaRow
+	for rows.Next() {
+		var i ListCarMediaRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CarID,
+			&i.Filename,
+			&i.UploadedAt,
+			&i.Variants,
+			&i.Kind,
+			&i.MimeType,
+			&i.Visibility,
+			&i.Position,
+			&i.SizeBytes,
+			&i.Checksum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listImageMatches = `-- name: ListImageMatches :many
+SELECT m.id, m.distance, m.created_at,
+    i.id AS image_id, i.car_id, c.owner_id, i.filename,
+    mi.id AS matched_image_id, mi.car_id AS matched_car_id, mc.owner_id AS matched_owner_id, mi.filename AS matched_filename
+FROM image_matches m
+JOIN images i ON i.id = m.image_id
+JOIN cars c ON c.id = i.car_id
+JOIN images mi ON mi.id = m.matched_image_id
+JOIN cars mc ON mc.id = mi.car_id
+WHERE $1::UUID IS NULL
+    OR i.car_id = $1::UUID
+    OR mi.car_id = $1::UUID
+ORDER BY m.created_at DESC
+LIMIT $3::INTEGER OFFSET $2::INTEGER
+`
+
+type ListImageMatchesParams struct {
+	CarID  pgtype.UUID `json:"car_id"`
+	Offset pgtype.Int4 `json:"offset"`
+	Limit  pgtype.Int4 `json:"limit"`
+}
+
+type ListImageMatchesRow struct {
+	ID              string             `json:"id"`
+	Distance        int32              `json:"distance"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	ImageID         string             `json:"image_id"`
+	CarID           string             `json:"car_id"`
+	OwnerID         string             `json:"owner_id"`
+	Filename        string             `json:"filename"`
+	MatchedImageID  string             `json:"matched_image_id"`
+	MatchedCarID    string             `json:"matched_car_id"`
+	MatchedOwnerID  string             `json:"matched_owner_id"`
+	MatchedFilename string             `json:"matched_filename"`
+}
+
+func (q *Queries) ListImageMatches(ctx context.Context, arg ListImageMatchesParams) ([]ListImageMatchesRow, error) {
+	rows, err := q.db.Query(ctx, listImageMatches, arg.CarID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListImageMatchesRow
+	for rows.Next() {
+		var i ListImageMatchesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Distance,
+			&i.CreatedAt,
+			&i.ImageID,
+			&i.CarID,
+			&i.OwnerID,
+			&i.Filename,
+			&i.MatchedImageID,
+			&i.MatchedCarID,
+			&i.MatchedOwnerID,
+			&i.MatchedFilename,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedImages = `-- name: PurgeDeletedImages :execrows
+DELETE FROM images
+WHERE (deleted_at <> 0 AND deleted_at < $1::BIGINT)
+    OR car_id IN (
+        SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < $1::BIGINT
+    )
+`
+
+func (q *Queries) PurgeDeletedImages(ctx context.Context, cutoff pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedImages, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreImage = `-- name: RestoreImage :execrows
+UPDATE images
+SET deleted_at = 0
+WHERE id = $1 AND kind = 'image' AND deleted_at <> 0
+    AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0)
+`
+
+func (q *Queries) RestoreImage(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreImage, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreImagesByCarId = `-- name: RestoreImagesByCarId :exec
+UPDATE images
+SET deleted_at = 0
+WHERE car_id = $1 AND deleted_at <> 0
+    AND deleted_at = (SELECT deleted_at FROM cars WHERE id = $1)
+`
+
+func (q *Queries) RestoreImagesByCarId(ctx context.Context, carID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, restoreImagesByCarId, carID)
+	return err
+}
+
+const setCoverImage = `-- name: SetCoverImage :execrows
+UPDATE images SET is_cover = TRUE
+WHERE id = $1 AND car_id = $2 AND kind = 'image' AND deleted_at = 0
+`
+
+type SetCoverImageParams struct {
+	ID    pgtype.UUID `json:"id"`
+	CarID pgtype.UUID `json:"car_id"`
+}
+
+func (q *Queries) SetCoverImage(ctx context.Context, arg SetCoverImageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setCoverImage, arg.ID, arg.CarID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setImagePositions = `-- name: SetImagePositions :exec
+UPDATE images i
+SET position = o.ord - 1
+FROM unnest($1::UUID[]) WITH ORDINALITY AS o(id, ord)
+WHERE i.id = o.id AND i.car_id = $2
+`
+
+type SetImagePositionsParams struct {
+	ImageIds []pgtype.UUID `json:"image_ids"`
+	CarID    pgtype.UUID   `json:"car_id"`
+}
+
+func (q *Queries) SetImagePositions(ctx context.Context, arg SetImagePositionsParams) error {
+	_, err := q.db.Exec(ctx, setImagePositions, arg.ImageIds, arg.CarID)
+	return err
+}
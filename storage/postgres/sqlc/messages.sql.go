@@ -12,10 +12,44 @@ import (
 	zero "gopkg.in/guregu/null.v4/zero"
 )
 
+const checkMessageCarParticipant = `-- name: CheckMessageCarParticipant :one
+SELECT EXISTS (
+    SELECT 1 FROM cars c
+    WHERE c.id = $1 AND c.deleted_at = 0 AND (
+        c.owner_id IN ($2, $3)
+        OR EXISTS (
+            SELECT 1 FROM organization_members om
+            WHERE om.org_id = c.organization_id
+                AND om.user_id IN ($2, $3)
+        )
+    )
+) AS is_participant
+`
+
+type CheckMessageCarParticipantParams struct {
+	CarID       pgtype.UUID `json:"car_id"`
+	SenderID    pgtype.UUID `json:"sender_id"`
+	RecipientID pgtype.UUID `json:"recipient_id"`
+}
+
+func (q *Queries) CheckMessageCarParticipant(ctx context.Context, arg CheckMessageCarParticipantParams) (bool, error) {
+	row := q.db.QueryRow(ctx, checkMessageCarParticipant, arg.CarID, arg.SenderID, arg.RecipientID)
+	var is_participant bool
+	err := row.Scan(&is_participant)
+	return is_participant, err
+}
+
 const checkMessageOwnership = `-- name: CheckMessageOwnership :one
 SELECT EXISTS (
-    SELECT 1 FROM messages 
-    WHERE id = $1 AND (sender_id = $2 OR recipient_id = $2)
+    SELECT 1 FROM messages m
+    WHERE m.id = $1 AND (
+        m.sender_id = $2 OR m.recipient_id = $2
+        OR EXISTS (
+            SELECT 1 FROM cars c
+            JOIN organization_members om ON om.org_id = c.organization_id
+            WHERE c.id = m.car_id AND om.user_id = $2
+        )
+    )
 ) AS is_owner
 `
 
@@ -32,15 +66,16 @@ func (q *Queries) CheckMessageOwnership(ctx context.Context, arg CheckMessageOwn
 }
 
 const createMessage = `-- name: CreateMessage :one
-INSERT INTO messages (sender_id, recipient_id, content)
-VALUES ($1, $2, $3)
-RETURNING id, sender_id, recipient_id, content, read, created_at, updated_at
+INSERT INTO messages (sender_id, recipient_id, content, car_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, sender_id, recipient_id, content, read, created_at, updated_at, car_id
 `
 
 type CreateMessageParams struct {
 	SenderID    pgtype.UUID `json:"sender_id"`
 	RecipientID pgtype.UUID `json:"recipient_id"`
 	Content     zero.String `json:"content"`
+	CarID       pgtype.UUID `json:"car_id"`
 }
 
 type CreateMessageRow struct {
@@ -51,10 +86,16 @@ type CreateMessageRow struct {
 	Read        pgtype.Bool        `json:"read"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CarID       pgtype.UUID        `json:"car_id"`
 }
 
 func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (CreateMessageRow, error) {
-	row := q.db.QueryRow(ctx, createMessage, arg.SenderID, arg.RecipientID, arg.Content)
+	row := q.db.QueryRow(ctx, createMessage,
+		arg.SenderID,
+		arg.RecipientID,
+		arg.Content,
+		arg.CarID,
+	)
 	var i CreateMessageRow
 	err := row.Scan(
 		&i.ID,
@@ -64,6 +105,7 @@ func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (C
 		&i.Read,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CarID,
 	)
 	return i, err
 }
@@ -95,7 +137,8 @@ SELECT
         ) ORDER BY created_at ASC
     ) AS messages
 FROM messages
-WHERE sender_id = $1 OR recipient_id = $1
+WHERE (sender_id = $1 OR recipient_id = $1)
+    AND NOT hidden
 GROUP BY user_id
 `
 
@@ -135,9 +178,10 @@ SELECT
     updated_at
 FROM messages
 WHERE 
-    (sender_id = $1 AND recipient_id = $2)
+    ((sender_id = $1 AND recipient_id = $2)
     OR 
-    (sender_id = $2 AND recipient_id = $1)
+    (sender_id = $2 AND recipient_id = $1))
+    AND NOT hidden
 ORDER BY created_at ASC
 `
 
@@ -194,3 +238,19 @@ func (q *Queries) MarkMessageAsRead(ctx context.Context, id pgtype.UUID) error {
 	_, err := q.db.Exec(ctx, markMessageAsRead, id)
 	return err
 }
+
+const setMessageHidden = `-- name: SetMessageHidden :exec
+UPDATE messages
+SET hidden = $1
+WHERE id = $2
+`
+
+type SetMessageHiddenParams struct {
+	Hidden bool        `json:"hidden"`
+	ID     pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) SetMessageHidden(ctx context.Context, arg SetMessageHiddenParams) error {
+	_, err := q.db.Exec(ctx, setMessageHidden, arg.Hidden, arg.ID)
+	return err
+}
@@ -12,6 +12,25 @@ import (
 	zero "gopkg.in/guregu/null.v4/zero"
 )
 
+const checkNotificationOwnership = `-- name: CheckNotificationOwnership :one
+SELECT EXISTS (
+    SELECT 1 FROM notifications
+    WHERE id = $1 AND user_id = $2
+) AS is_owner
+`
+
+type CheckNotificationOwnershipParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) CheckNotificationOwnership(ctx context.Context, arg CheckNotificationOwnershipParams) (bool, error) {
+	row := q.db.QueryRow(ctx, checkNotificationOwnership, arg.ID, arg.UserID)
+	var is_owner bool
+	err := row.Scan(&is_owner)
+	return is_owner, err
+}
+
 const createNotification = `-- name: CreateNotification :one
 INSERT INTO notifications (user_id, type, message)
 VALUES ($1, $2, $3)
@@ -48,7 +67,9 @@ func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotification
 }
 
 const deleteNotification = `-- name: DeleteNotification :exec
-DELETE FROM notifications WHERE id = $1
+UPDATE notifications
+SET deleted_at = EXTRACT(EPOCH FROM CURRENT_TIMESTAMP)::BIGINT, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at = 0
 `
 
 func (q *Queries) DeleteNotification(ctx context.Context, id pgtype.UUID) error {
@@ -150,3 +171,30 @@ func (q *Queries) MarkNotificationAsRead(ctx context.Context, id pgtype.UUID) er
 	_, err := q.db.Exec(ctx, markNotificationAsRead, id)
 	return err
 }
+
+const purgeDeletedNotifications = `-- name: PurgeDeletedNotifications :execrows
+DELETE FROM notifications
+WHERE deleted_at <> 0 AND deleted_at < $1::BIGINT
+`
+
+func (q *Queries) PurgeDeletedNotifications(ctx context.Context, cutoff pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedNotifications, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreNotification = `-- name: RestoreNotification :execrows
+UPDATE notifications
+SET deleted_at = 0, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND deleted_at <> 0
+`
+
+func (q *Queries) RestoreNotification(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreNotification, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
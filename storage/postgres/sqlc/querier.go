@@ -8,22 +8,52 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	zero "gopkg.in/guregu/null.v4/zero"
 )
 
 type Querier interface {
+	AddCarMakeAlias(ctx context.Context, arg AddCarMakeAliasParams) (string, error)
+	AddCarModelAlias(ctx context.Context, arg AddCarModelAliasParams) error
 	AddImage(ctx context.Context, arg AddImageParams) (AddImageRow, error)
+	AddMedia(ctx context.Context, arg AddMediaParams) (AddMediaRow, error)
+	AutocompleteCarMakes(ctx context.Context, arg AutocompleteCarMakesParams) ([]string, error)
+	AutocompleteCarModels(ctx context.Context, arg AutocompleteCarModelsParams) ([]AutocompleteCarModelsRow, error)
+	CarMakeKeyTaken(ctx context.Context, arg CarMakeKeyTakenParams) (bool, error)
+	CarModelKeyTaken(ctx context.Context, arg CarModelKeyTakenParams) (bool, error)
 	CheckCarOwnership(ctx context.Context, arg CheckCarOwnershipParams) (bool, error)
 	CheckCommentOwnership(ctx context.Context, arg CheckCommentOwnershipParams) (bool, error)
+	CheckImageOwnership(ctx context.Context, arg CheckImageOwnershipParams) (bool, error)
+	CheckMessageCarParticipant(ctx context.Context, arg CheckMessageCarParticipantParams) (bool, error)
 	CheckMessageOwnership(ctx context.Context, arg CheckMessageOwnershipParams) (bool, error)
+	CheckNotificationOwnership(ctx context.Context, arg CheckNotificationOwnershipParams) (bool, error)
 	CheckSavedCarOwnership(ctx context.Context, arg CheckSavedCarOwnershipParams) (bool, error)
+	ClearCoverImage(ctx context.Context, carID pgtype.UUID) error
+	ClearSearchSuggestions(ctx context.Context) error
+	CountActiveListings(ctx context.Context, arg CountActiveListingsParams) (int64, error)
+	CountCarComments(ctx context.Context, carID pgtype.UUID) (int64, error)
+	CountCarImages(ctx context.Context, carID pgtype.UUID) (int64, error)
+	CountCarMedia(ctx context.Context, carID pgtype.UUID) (int64, error)
+	CountOpenReporters(ctx context.Context, arg CountOpenReportersParams) (int64, error)
+	CountOrganizationOwners(ctx context.Context, orgID pgtype.UUID) (int64, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
 	CreateCar(ctx context.Context, arg CreateCarParams) (CreateCarRow, error)
+	CreateCarTransfer(ctx context.Context, arg CreateCarTransferParams) (CreateCarTransferRow, error)
 	CreateComment(ctx context.Context, arg CreateCommentParams) (CreateCommentRow, error)
+	CreateImageMatch(ctx context.Context, arg CreateImageMatchParams) error
 	CreateMessage(ctx context.Context, arg CreateMessageParams) (CreateMessageRow, error)
 	CreateNotification(ctx context.Context, arg CreateNotificationParams) (CreateNotificationRow, error)
 	CreateNotificationToken(ctx context.Context, arg CreateNotificationTokenParams) (CreateNotificationTokenRow, error)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (CreateOrganizationRow, error)
+	CreateReport(ctx context.Context, arg CreateReportParams) (CreateReportRow, error)
+	CreateReview(ctx context.Context, arg CreateReviewParams) (CreateReviewRow, error)
 	CreateSavedCar(ctx context.Context, arg CreateSavedCarParams) (CreateSavedCarRow, error)
 	DeleteCar(ctx context.Context, id pgtype.UUID) error
+	DeleteCarDescription(ctx context.Context, arg DeleteCarDescriptionParams) error
+	DeleteCarGeneration(ctx context.Context, id pgtype.UUID) (int64, error)
+	DeleteCarMake(ctx context.Context, id pgtype.UUID) (int64, error)
+	DeleteCarModel(ctx context.Context, id pgtype.UUID) (int64, error)
 	DeleteComment(ctx context.Context, id pgtype.UUID) error
+	DeleteCommentReaction(ctx context.Context, arg DeleteCommentReactionParams) (int64, error)
 	DeleteCommentsByCarId(ctx context.Context, carID pgtype.UUID) error
 	DeleteImage(ctx context.Context, id pgtype.UUID) error
 	DeleteImagesByCarId(ctx context.Context, carID pgtype.UUID) error
@@ -31,26 +61,118 @@ type Querier interface {
 	DeleteNotification(ctx context.Context, id pgtype.UUID) error
 	DeleteNotificationToken(ctx context.Context, id pgtype.UUID) error
 	DeleteNotificationTokensByUserId(ctx context.Context, userID pgtype.UUID) error
+	DeleteReview(ctx context.Context, id pgtype.UUID) error
 	DeleteSavedCar(ctx context.Context, id pgtype.UUID) error
 	DeleteSavedCarsByCarId(ctx context.Context, carID pgtype.UUID) error
+	EnqueueModeration(ctx context.Context, arg EnqueueModerationParams) error
+	EnqueueModerationIfAbsent(ctx context.Context, arg EnqueueModerationIfAbsentParams) error
+	EnsureCoverImage(ctx context.Context, carID pgtype.UUID) error
+	FindSimilarImages(ctx context.Context, arg FindSimilarImagesParams) ([]FindSimilarImagesRow, error)
+	GetAuditTrail(ctx context.Context, arg GetAuditTrailParams) ([]GetAuditTrailRow, error)
 	GetCarById(ctx context.Context, id pgtype.UUID) (GetCarByIdRow, error)
-	GetCommentsByCar(ctx context.Context, carID pgtype.UUID) ([]GetCommentsByCarRow, error)
+	GetCarDescriptions(ctx context.Context, carID pgtype.UUID) ([]GetCarDescriptionsRow, error)
+	GetCarDescriptionsByCarIds(ctx context.Context, carIds []pgtype.UUID) ([]GetCarDescriptionsByCarIdsRow, error)
+	GetCarMemberRole(ctx context.Context, arg GetCarMemberRoleParams) (string, error)
+	GetCarModerationStatus(ctx context.Context, id pgtype.UUID) (string, error)
+	GetCarOwnerId(ctx context.Context, id pgtype.UUID) (string, error)
+	GetCarRating(ctx context.Context, id pgtype.UUID) (GetCarRatingRow, error)
+	GetCarTransfer(ctx context.Context, id pgtype.UUID) (GetCarTransferRow, error)
+	GetCommentById(ctx context.Context, id pgtype.UUID) (GetCommentByIdRow, error)
+	GetCommentModerationStatus(ctx context.Context, id pgtype.UUID) (string, error)
+	GetCommentReactionCounts(ctx context.Context, commentIds []pgtype.UUID) ([]GetCommentReactionCountsRow, error)
+	GetCommentReplies(ctx context.Context, arg GetCommentRepliesParams) ([]GetCommentRepliesRow, error)
+	GetCommentThreadInfo(ctx context.Context, id pgtype.UUID) (GetCommentThreadInfoRow, error)
+	GetCommentsByCarMostLiked(ctx context.Context, arg GetCommentsByCarMostLikedParams) ([]GetCommentsByCarMostLikedRow, error)
+	GetCommentsByCarNewest(ctx context.Context, arg GetCommentsByCarNewestParams) ([]GetCommentsByCarNewestRow, error)
+	GetCommentsByCarOldest(ctx context.Context, arg GetCommentsByCarOldestParams) ([]GetCommentsByCarOldestRow, error)
+	GetDeletedImageCar(ctx context.Context, id pgtype.UUID) (GetDeletedImageCarRow, error)
 	GetImageById(ctx context.Context, id pgtype.UUID) (GetImageByIdRow, error)
 	GetImagesByCar(ctx context.Context, carID pgtype.UUID) ([]GetImagesByCarRow, error)
+	GetMediaById(ctx context.Context, id pgtype.UUID) (GetMediaByIdRow, error)
 	GetMessagesByUser(ctx context.Context, userID pgtype.UUID) ([]GetMessagesByUserRow, error)
 	GetMessagesByUserAndId(ctx context.Context, arg GetMessagesByUserAndIdParams) ([]GetMessagesByUserAndIdRow, error)
+	GetModerationItem(ctx context.Context, id pgtype.UUID) (GetModerationItemRow, error)
 	GetNotificationTokensByUserId(ctx context.Context, userID pgtype.UUID) ([]GetNotificationTokensByUserIdRow, error)
 	GetNotificationsByUser(ctx context.Context, userID pgtype.UUID) ([]GetNotificationsByUserRow, error)
+	GetOrganization(ctx context.Context, id pgtype.UUID) (GetOrganizationRow, error)
+	GetOrganizationMemberRole(ctx context.Context, arg GetOrganizationMemberRoleParams) (string, error)
+	GetPriceComparables(ctx context.Context, arg GetPriceComparablesParams) (GetPriceComparablesRow, error)
+	GetPriceComparablesBatch(ctx context.Context, arg GetPriceComparablesBatchParams) ([]GetPriceComparablesBatchRow, error)
+	GetReport(ctx context.Context, id pgtype.UUID) (GetReportRow, error)
+	GetReviewById(ctx context.Context, id pgtype.UUID) (GetReviewByIdRow, error)
+	GetReviewModerationStatus(ctx context.Context, id pgtype.UUID) (string, error)
+	GetSavedCarUsersByCarId(ctx context.Context, carID pgtype.UUID) ([]string, error)
 	GetSavedCarsByUser(ctx context.Context, userID pgtype.UUID) ([]GetSavedCarsByUserRow, error)
+	GetSellerRating(ctx context.Context, sellerID pgtype.UUID) (GetSellerRatingRow, error)
+	GetTrendingSearches(ctx context.Context, arg GetTrendingSearchesParams) ([]GetTrendingSearchesRow, error)
 	GetUnreadNotificationsByUser(ctx context.Context, userID pgtype.UUID) ([]GetUnreadNotificationsByUserRow, error)
+	GetUserCommentReactions(ctx context.Context, arg GetUserCommentReactionsParams) ([]GetUserCommentReactionsRow, error)
+	GetUserPlan(ctx context.Context, userID pgtype.UUID) (GetUserPlanRow, error)
+	HasExchangedMessages(ctx context.Context, arg HasExchangedMessagesParams) (bool, error)
 	IncrementCarReviewCount(ctx context.Context, id pgtype.UUID) error
+	ListCarImageFiles(ctx context.Context, carID pgtype.UUID) ([]ListCarImageFilesRow, error)
+	ListCarMedia(ctx context.Context, arg ListCarMediaParams) ([]ListCarMediaRow, error)
+	ListCarTransfersByUser(ctx context.Context, arg ListCarTransfersByUserParams) ([]ListCarTransfersByUserRow, error)
 	ListCars(ctx context.Context, arg ListCarsParams) ([]ListCarsRow, error)
+	ListImageMatches(ctx context.Context, arg ListImageMatchesParams) ([]ListImageMatchesRow, error)
+	ListOrganizationMembers(ctx context.Context, orgID pgtype.UUID) ([]ListOrganizationMembersRow, error)
+	ListOrganizationMessages(ctx context.Context, arg ListOrganizationMessagesParams) ([]ListOrganizationMessagesRow, error)
+	ListPendingModeration(ctx context.Context, arg ListPendingModerationParams) ([]ListPendingModerationRow, error)
+	ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error)
+	ListReviews(ctx context.Context, arg ListReviewsParams) ([]ListReviewsRow, error)
+	ListUserOrganizations(ctx context.Context, userID pgtype.UUID) ([]ListUserOrganizationsRow, error)
+	LockQuota(ctx context.Context, key zero.String) error
+	LogSearchQuery(ctx context.Context, arg LogSearchQueryParams) error
 	MarkMessageAsRead(ctx context.Context, id pgtype.UUID) error
 	MarkNotificationAsRead(ctx context.Context, id pgtype.UUID) error
+	PurgeDeletedCars(ctx context.Context, cutoff pgtype.Int8) (int64, error)
+	PurgeDeletedComments(ctx context.Context, cutoff pgtype.Int8) (int64, error)
+	PurgeDeletedImages(ctx context.Context, cutoff pgtype.Int8) (int64, error)
+	PurgeDeletedNotifications(ctx context.Context, cutoff pgtype.Int8) (int64, error)
+	PurgeSavedCarsOfDeletedCars(ctx context.Context, cutoff pgtype.Int8) (int64, error)
+	PurgeSearchQueries(ctx context.Context, cutoff pgtype.Timestamptz) (int64, error)
+	RebuildSearchSuggestions(ctx context.Context, arg RebuildSearchSuggestionsParams) (int64, error)
+	RefreshCarRating(ctx context.Context, carID pgtype.UUID) error
+	RefreshCommentLikeCount(ctx context.Context, id pgtype.UUID) error
+	RefreshSellerRating(ctx context.Context, sellerID pgtype.UUID) error
+	RemoveOrganizationMember(ctx context.Context, arg RemoveOrganizationMemberParams) (int64, error)
+	ResolveCarMake(ctx context.Context, key string) (ResolveCarMakeRow, error)
+	ResolveCarModel(ctx context.Context, arg ResolveCarModelParams) (ResolveCarModelRow, error)
+	ResolveReportsByEntity(ctx context.Context, arg ResolveReportsByEntityParams) (int64, error)
+	RespondCarTransfer(ctx context.Context, arg RespondCarTransferParams) (int64, error)
+	RestoreCar(ctx context.Context, id pgtype.UUID) (int64, error)
+	RestoreComment(ctx context.Context, id pgtype.UUID) (int64, error)
+	RestoreCommentsByCarId(ctx context.Context, carID pgtype.UUID) error
+	RestoreImage(ctx context.Context, id pgtype.UUID) (int64, error)
+	RestoreImagesByCarId(ctx context.Context, carID pgtype.UUID) error
+	RestoreNotification(ctx context.Context, id pgtype.UUID) (int64, error)
+	ReviewModerationItem(ctx context.Context, arg ReviewModerationItemParams) (int64, error)
 	SearchCar(ctx context.Context, arg SearchCarParams) ([]SearchCarRow, error)
+	SetCarModerationStatus(ctx context.Context, arg SetCarModerationStatusParams) error
+	SetCarOrganization(ctx context.Context, arg SetCarOrganizationParams) error
+	SetCommentModerationStatus(ctx context.Context, arg SetCommentModerationStatusParams) error
+	SetCoverImage(ctx context.Context, arg SetCoverImageParams) (int64, error)
+	SetImagePositions(ctx context.Context, arg SetImagePositionsParams) error
+	SetMessageHidden(ctx context.Context, arg SetMessageHiddenParams) error
+	SetReviewModerationStatus(ctx context.Context, arg SetReviewModerationStatusParams) error
+	SetUserPlan(ctx context.Context, arg SetUserPlanParams) (int64, error)
+	SuggestSearch(ctx context.Context, arg SuggestSearchParams) ([]SuggestSearchRow, error)
+	TransferCarOwner(ctx context.Context, arg TransferCarOwnerParams) (int64, error)
+	TransferPendingModerationOwner(ctx context.Context, arg TransferPendingModerationOwnerParams) error
+	TransitionCarModerationStatus(ctx context.Context, arg TransitionCarModerationStatusParams) error
+	TransitionCommentModerationStatus(ctx context.Context, arg TransitionCommentModerationStatusParams) error
+	TransitionReviewModerationStatus(ctx context.Context, arg TransitionReviewModerationStatusParams) error
+	TriageReport(ctx context.Context, arg TriageReportParams) (int64, error)
 	UpdateCar(ctx context.Context, arg UpdateCarParams) error
 	UpdateComment(ctx context.Context, arg UpdateCommentParams) error
 	UpdateNotificationToken(ctx context.Context, arg UpdateNotificationTokenParams) error
+	UpdateReview(ctx context.Context, arg UpdateReviewParams) (UpdateReviewRow, error)
+	UpsertCarDescription(ctx context.Context, arg UpsertCarDescriptionParams) error
+	UpsertCarGeneration(ctx context.Context, arg UpsertCarGenerationParams) (UpsertCarGenerationRow, error)
+	UpsertCarMake(ctx context.Context, arg UpsertCarMakeParams) (UpsertCarMakeRow, error)
+	UpsertCarModel(ctx context.Context, arg UpsertCarModelParams) (UpsertCarModelRow, error)
+	UpsertCommentReaction(ctx context.Context, arg UpsertCommentReactionParams) error
+	UpsertOrganizationMember(ctx context.Context, arg UpsertOrganizationMemberParams) error
 }
 
 var _ Querier = (*Queries)(nil)
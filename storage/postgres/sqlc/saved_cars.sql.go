@@ -83,10 +83,37 @@ func (q *Queries) DeleteSavedCarsByCarId(ctx context.Context, carID pgtype.UUID)
 	return err
 }
 
+const getSavedCarUsersByCarId = `-- name: GetSavedCarUsersByCarId :many
+SELECT DISTINCT user_id
+FROM saved_cars
+WHERE car_id = $1
+`
+
+func (q *Queries) GetSavedCarUsersByCarId(ctx context.Context, carID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getSavedCarUsersByCarId, carID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSavedCarsByUser = `-- name: GetSavedCarsByUser :many
 SELECT id, user_id, car_id, created_at, updated_at
 FROM saved_cars
 WHERE user_id = $1
+    AND car_id IN (SELECT id FROM cars WHERE deleted_at = 0)
 `
 
 type GetSavedCarsByUserRow struct {
@@ -122,3 +149,18 @@ func (q *Queries) GetSavedCarsByUser(ctx context.Context, userID pgtype.UUID) ([
 	}
 	return items, nil
 }
+
+const purgeSavedCarsOfDeletedCars = `-- name: PurgeSavedCarsOfDeletedCars :execrows
+DELETE FROM saved_cars
+WHERE car_id IN (
+    SELECT id FROM cars WHERE deleted_at <> 0 AND deleted_at < $1::BIGINT
+)
+`
+
+func (q *Queries) PurgeSavedCarsOfDeletedCars(ctx context.Context, cutoff pgtype.Int8) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeSavedCarsOfDeletedCars, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
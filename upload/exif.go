@@ -0,0 +1,110 @@
+package upload
+
+import (
+	"encoding/binary"
+	"image"
+)
+
+// exifOrientationTag - EXIF dagi Orientation tegi
+const exifOrientationTag = 0x0112
+
+// jpegOrientation - JPEG APP1 (Exif) segmentidan Orientation qiymatini o'qiydi (1..8).
+// Topilmasa yoki segment buzilgan bo'lsa 1 (burilishsiz) qaytaradi
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 { // SOS yoki EOI: metadata tugadi
+			return 1
+		}
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+	return 1
+}
+
+// tiffOrientation - TIFF sarlavhasidagi birinchi IFD dan Orientation ni qidiradi
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < count; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != exifOrientationTag {
+			continue
+		}
+		if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+			return o
+		}
+		return 1
+	}
+	return 1
+}
+
+// orient - rasmni EXIF Orientation bo'yicha to'g'ri holatga keltiradi
+func orient(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // gorizontal aks
+				sx, sy = w-1-x, y
+			case 3: // 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // vertikal aks
+				sx, sy = x, h-1-y
+			case 5: // transpose
+				sx, sy = y, x
+			case 6: // soat yo'nalishida 90°
+				sx, sy = y, h-1-x
+			case 7: // transverse
+				sx, sy = w-1-y, h-1-x
+			case 8: // soat yo'nalishiga teskari 90°
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, src.At(b.Min.X+sx, b.Min.Y+sy))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,383 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fileField - multipart formadagi fayl maydoni nomi
+const fileField = "file"
+
+// errTooLarge - fayl ruxsat etilgan hajmdan katta
+var errTooLarge = errors.New("file too large")
+
+// Handler - multipart yuklashlarni BlobStore ga oqim bilan yozadi va
+// yozuvni gRPC servis orqali yaratadi (kvota, audit va tekshiruvlar o'sha yerda)
+type Handler struct {
+	mux     *runtime.ServeMux
+	store   blob.BlobStore
+	client  pb.CrudsServiceClient
+	logger  *slog.Logger
+	maxSize int64
+	// maxDimension - rasmning har bir tomoni uchun maksimal piksel
+	maxDimension int
+	// verifier - lokal saqlashdagi imzolangan URL lar tekshiruvchisi (S3 da nil)
+	verifier *blob.HMACSigner
+	// video va hujjatlar uchun maksimal hajm
+	maxVideoSize    int64
+	maxDocumentSize int64
+}
+
+func NewHandler(mux *runtime.ServeMux, store blob.BlobStore, client pb.CrudsServiceClient, logger *slog.Logger, maxSize int64, maxDimension int, verifier *blob.HMACSigner, maxVideoSize, maxDocumentSize int64) *Handler {
+	return &Handler{
+		mux:             mux,
+		store:           store,
+		client:          client,
+		logger:          logger,
+		maxSize:         maxSize,
+		maxDimension:    maxDimension,
+		verifier:        verifier,
+		maxVideoSize:    maxVideoSize,
+		maxDocumentSize: maxDocumentSize,
+	}
+}
+
+// UploadCarImage - POST /v1/cars/{car_id}/images/upload, "file" maydonidagi rasmni saqlaydi
+func (h *Handler) UploadCarImage(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := requestContext(r)
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	// 1. Validatsiya
+	carID, err := uuid.Parse(pathParams["car_id"])
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "invalid car ID format"))
+		return
+	}
+
+	// 2. Ruxsat (tana o'qilishidan oldin) va fayl qismini topish (butun so'rov xotiraga o'qilmaydi)
+	if !h.authorizeCar(w, r, outbound, carID) {
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+1<<20)
+	part, err := filePart(r)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	defer part.Close()
+
+	// 3. Tur, o'lcham va butunlikni tekshirish, metadata ni olib tashlash
+	clean, err := h.sanitize(&limitedReader{r: part, remaining: h.maxSize})
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, h.sanitizeError(err))
+		return
+	}
+
+	// 4-6. Saqlash va yozuv yaratish
+	h.saveImage(w, r, outbound, carID, clean)
+}
+
+// ConfirmUpload - POST /v1/cars/{car_id}/images/confirm, {"key": "..."}. Imzolangan URL orqali
+// yuklangan faylni oddiy yuklash kabi tekshiradi, doimiy kalitga ko'chiradi va yozuv yaratadi
+func (h *Handler) ConfirmUpload(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := requestContext(r)
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	// 1. Validatsiya
+	carID, err := uuid.Parse(pathParams["car_id"])
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "invalid car ID format"))
+		return
+	}
+	var body struct {
+		Key string `json:"key"`
+	}
+	if err := json.NewDecoder(io.LimitReader(r.Body, 4<<10)).Decode(&body); err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "invalid request body"))
+		return
+	}
+	if !strings.HasPrefix(body.Key, blob.IncomingPrefix(carID.String())) {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "key was not issued for this car"))
+		return
+	}
+	if !h.authorizeCar(w, r, outbound, carID) {
+		return
+	}
+
+	// 2. Imzolangan PUT hajmni cheklamaydi: chegaradan katta fayl o'qilmasdan o'chiriladi
+	obj, err := h.store.Stat(ctx, body.Key)
+	if errors.Is(err, blob.ErrNotFound) {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.NotFound, "upload not found"))
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to stat upload", "key", body.Key, "error", err)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.Internal, "failed to read upload"))
+		return
+	}
+	if obj.Size > h.maxSize {
+		h.deleteBlob(body.Key)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, h.sanitizeError(errTooLarge))
+		return
+	}
+
+	// 3. Yuklangan faylni o'qish va tekshirish. Rad etilgan fayl saqlanib qolmaydi
+	rc, err := h.store.Get(ctx, body.Key)
+	if errors.Is(err, blob.ErrNotFound) {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.NotFound, "upload not found"))
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to read upload", "key", body.Key, "error", err)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.Internal, "failed to read upload"))
+		return
+	}
+	clean, err := h.sanitize(&limitedReader{r: rc, remaining: h.maxSize})
+	rc.Close()
+	if err != nil {
+		if errors.Is(err, errTooLarge) || errors.Is(err, errInvalidImage) {
+			h.deleteBlob(body.Key)
+		}
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, h.sanitizeError(err))
+		return
+	}
+
+	// 4. Doimiy kalit ostida saqlash va yozuv yaratish
+	if h.saveImage(w, r, outbound, carID, clean) {
+		h.deleteBlob(body.Key)
+	}
+}
+
+// saveImage - tekshirilgan rasmni va uning o'lchamlarini saqlaydi, so'ng gRPC orqali
+// images yozuvini yaratib javobni yozadi. Muvaffaqiyatli bo'lsa true qaytaradi
+func (h *Handler) saveImage(w http.ResponseWriter, r *http.Request, outbound runtime.Marshaler, carID uuid.UUID, clean *cleanImage) bool {
+	ctx := requestContext(r)
+
+	// 4. Blob saqlash (kengaytma aniqlangan turdan olinadi)
+	key := objectKey(carID.String(), clean.ext)
+	if err := h.store.Put(ctx, key, bytes.NewReader(clean.data), clean.contentType); err != nil {
+		h.logger.Error("failed to store upload", "key", key, "error", err)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.Internal, "failed to store file"))
+		return false
+	}
+
+	// 5. Ro'yxat uchun kichraytirilgan o'lchamlar. Yaratib bo'lmasa, rasm faqat asli bilan qoladi
+	variants, err := h.storeVariants(ctx, key, clean.img)
+	if err != nil {
+		h.logger.Warn("failed to create image variants", "key", key, "error", err)
+		h.deleteBlob(variantKeys(key, variants)...)
+		variants = nil
+	}
+	keys := append([]string{key}, variantKeys(key, variants)...)
+
+	// 6. images yozuvini yaratish (Authorization va boshqa sarlavhalar metadata ga uzatiladi)
+	rctx, err := runtime.AnnotateContext(ctx, h.mux, r, pb.CrudsService_AddImage_FullMethodName)
+	if err != nil {
+		h.deleteBlob(keys...)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return false
+	}
+	var md runtime.ServerMetadata
+	image, err := h.client.AddImage(rctx, &pb.AddImageRequest{
+		CarId:     carID.String(),
+		Filename:  key,
+		Variants:  variants,
+		Width:     int32(clean.width),
+		Height:    int32(clean.height),
+		SizeBytes: int64(len(clean.data)),
+		Checksum:  clean.checksum,
+		Phash:     clean.phash,
+		MimeType:  clean.contentType,
+	}, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+	rctx = runtime.NewServerMetadataContext(rctx, md)
+	if err != nil {
+		h.deleteBlob(keys...)
+		runtime.HTTPError(rctx, h.mux, outbound, w, r, err)
+		return false
+	}
+
+	runtime.ForwardResponseMessage(rctx, h.mux, outbound, w, r, image)
+	return true
+}
+
+// authorizeCar - fayl tanasi o'qilishidan oldin e'lonni tahrirlash huquqini gRPC orqali tekshiradi,
+// begona e'longa yuborilgan fayl xotiraga ham, saqlashga ham tushmaydi
+func (h *Handler) authorizeCar(w http.ResponseWriter, r *http.Request, outbound runtime.Marshaler, carID uuid.UUID) bool {
+	ctx := requestContext(r)
+	rctx, err := runtime.AnnotateContext(ctx, h.mux, r, pb.CrudsService_AuthorizeCarUpload_FullMethodName)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return false
+	}
+	if _, err := h.client.AuthorizeCarUpload(rctx, &pb.CarId{CarId: carID.String()}); err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return false
+	}
+	return true
+}
+
+// requestContext - gRPC chaqiruvidan oldingi xato javoblari uchun bo'sh ServerMetadata
+// (runtime.HTTPError uni kontekstdan qidiradi)
+func requestContext(r *http.Request) context.Context {
+	return runtime.NewServerMetadataContext(r.Context(), runtime.ServerMetadata{})
+}
+
+// sanitizeError - tekshiruv xatosini gRPC statusiga aylantiradi
+func (h *Handler) sanitizeError(err error) error {
+	switch {
+	case errors.Is(err, errTooLarge):
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("file exceeds %d bytes", h.maxSize))
+	case errors.Is(err, errInvalidImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		h.logger.Error("failed to process upload", "error", err)
+		return status.Error(codes.Internal, "failed to process file")
+	}
+}
+
+// PutFile - PUT /v1/files/{key}, lokal saqlashda imzolangan URL orqali to'g'ridan-to'g'ri yuklash.
+// S3 da mijoz faylni bucket ga o'zi yozadi, shuning uchun bu marshrut faqat lokal saqlash uchun
+func (h *Handler) PutFile(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	key := pathParams["key"]
+	if h.verifier == nil || !blob.IsIncoming(key) {
+		http.NotFound(w, r)
+		return
+	}
+	if err := h.verifier.Verify(http.MethodPut, key, r.URL.Query()); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	body := &limitedReader{r: r.Body, remaining: h.maxSize}
+	if err := h.store.Put(r.Context(), key, body, r.Header.Get("Content-Type")); err != nil {
+		if errors.Is(err, errTooLarge) {
+			http.Error(w, fmt.Sprintf("file exceeds %d bytes", h.maxSize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		h.logger.Error("failed to store direct upload", "key", key, "error", err)
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// ServeFile - GET /v1/files/{key}, saqlangan fayl yoki uning o'lchamini qaytaradi.
+// Kalitlar o'zgarmas (har yuklash yangi UUID), shuning uchun uzoq keshlanadi.
+// Yopiq (private/) kalitlar faqat imzolangan URL bilan beriladi va keshlanmaydi. Ochiq kalit
+// imzo parametrlari bilan so'ralsa ham imzo tekshiriladi: muddati o'tgan URL ishlamasligi kerak
+func (h *Handler) ServeFile(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	key := pathParams["key"]
+	query := r.URL.Query()
+	private := blob.IsPrivate(key) || query.Has("expires") || query.Has("signature")
+	if private && (h.verifier == nil || h.verifier.Verify(http.MethodGet, key, query) != nil) {
+		http.Error(w, "invalid or expired signature", http.StatusForbidden)
+		return
+	}
+
+	rc, err := h.store.Get(r.Context(), key)
+	if errors.Is(err, blob.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to read file", "key", key, "error", err)
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	if ct := mime.TypeByExtension(path.Ext(key)); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if private {
+		w.Header().Set("Cache-Control", "private, no-store")
+	} else {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	if _, err := io.Copy(w, rc); err != nil {
+		h.logger.Warn("failed to write file", "key", key, "error", err)
+	}
+}
+
+// deleteBlob - yozuv yaratilmagan fayllarni tozalash (xato faqat log qilinadi)
+func (h *Handler) deleteBlob(keys ...string) {
+	for _, key := range keys {
+		if err := h.store.Delete(context.Background(), key); err != nil {
+			h.logger.Warn("failed to delete orphan upload", "key", key, "error", err)
+		}
+	}
+}
+
+func variantKeys(key string, variants []string) []string {
+	keys := make([]string, len(variants))
+	for i, v := range variants {
+		keys[i] = blob.VariantKey(key, v)
+	}
+	return keys
+}
+
+// filePart - multipart so'rovdan "file" maydonini qaytaradi, qolgan maydonlar o'tkazib yuboriladi
+func filePart(r *http.Request) (*multipart.Part, error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, errors.New("multipart/form-data body expected")
+	}
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%q field is required", fileField)
+		}
+		if err != nil {
+			return nil, errors.New("malformed multipart body")
+		}
+		if part.FormName() == fileField && part.FileName() != "" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
+
+// objectKey - cars/{car_id}/{uuid}{ext}. Mijoz bergan fayl nomi ishlatilmaydi
+func objectKey(carID, ext string) string {
+	return "cars/" + carID + "/" + uuid.NewString() + ext
+}
+
+// limitedReader - remaining baytdan ko'p o'qilsa errTooLarge qaytaradi
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, errTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errTooLarge
+	}
+	return n, err
+}
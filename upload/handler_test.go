@@ -0,0 +1,123 @@
+package upload
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authClient - faqat AuthorizeCarUpload javobini beradigan soxta gRPC klient
+type authClient struct {
+	pb.CrudsServiceClient
+	err error
+}
+
+func (c *authClient) AuthorizeCarUpload(ctx context.Context, in *pb.CarId, opts ...grpc.CallOption) (*pb.Empty, error) {
+	return &pb.Empty{}, c.err
+}
+
+// recordingStore - saqlashga murojaatlarni sanaydi, obyektlar yo'q deb javob beradi
+type recordingStore struct {
+	blob.BlobStore
+	calls int
+}
+
+func (s *recordingStore) Stat(ctx context.Context, key string) (blob.Object, error) {
+	s.calls++
+	return blob.Object{}, blob.ErrNotFound
+}
+
+func (s *recordingStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.calls++
+	return nil, blob.ErrNotFound
+}
+
+func (s *recordingStore) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	s.calls++
+	return nil
+}
+
+// trackedBody - so'rov tanasi o'qilganini belgilaydi
+type trackedBody struct {
+	io.Reader
+	read bool
+}
+
+func (b *trackedBody) Read(p []byte) (int, error) {
+	b.read = true
+	return b.Reader.Read(p)
+}
+
+func (b *trackedBody) Close() error { return nil }
+
+func TestUploadsAuthorizeBeforeReading(t *testing.T) {
+	carID := uuid.NewString()
+
+	handlers := []struct {
+		name   string
+		target string
+		body   string
+		handle func(h *Handler) runtime.HandlerFunc
+		// reads - ruxsat tekshiruvidan oldin tanani o'qish kerakmi (faqat kichik JSON)
+		reads bool
+		// allowed - ruxsat berilganda kutilgan status (fayl saqlashda yo'q)
+		allowed int
+	}{
+		{"UploadCarImage", "/v1/cars/" + carID + "/images/upload", "",
+			func(h *Handler) runtime.HandlerFunc { return h.UploadCarImage }, false, http.StatusBadRequest},
+		{"UploadCarMedia", "/v1/cars/" + carID + "/media/upload?kind=document", "",
+			func(h *Handler) runtime.HandlerFunc { return h.UploadCarMedia }, false, http.StatusBadRequest},
+		{"ConfirmUpload", "/v1/cars/" + carID + "/images/confirm", `{"key":"` + blob.IncomingKey(carID) + `"}`,
+			func(h *Handler) runtime.HandlerFunc { return h.ConfirmUpload }, true, http.StatusNotFound},
+	}
+
+	for _, hh := range handlers {
+		for _, denied := range []bool{true, false} {
+			name := hh.name + "/allowed"
+			if denied {
+				name = hh.name + "/denied"
+			}
+			t.Run(name, func(t *testing.T) {
+				client := &authClient{}
+				if denied {
+					client.err = status.Error(codes.PermissionDenied, "you don't have permission to modify this car")
+				}
+				store := &recordingStore{}
+				h := NewHandler(runtime.NewServeMux(), store, client, slog.New(slog.NewTextHandler(io.Discard, nil)), 1<<20, 4096, nil, 1<<20, 1<<20)
+
+				body := &trackedBody{Reader: strings.NewReader(hh.body)}
+				r := httptest.NewRequest(http.MethodPost, hh.target, body)
+				w := httptest.NewRecorder()
+				hh.handle(h)(w, r, map[string]string{"car_id": carID})
+
+				if !denied {
+					if w.Code != hh.allowed {
+						t.Fatalf("status = %d, want %d: %s", w.Code, hh.allowed, w.Body)
+					}
+					return
+				}
+				if w.Code != http.StatusForbidden {
+					t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusForbidden, w.Body)
+				}
+				if body.read && !hh.reads {
+					t.Fatal("request body was read before authorization")
+				}
+				if store.calls != 0 {
+					t.Fatalf("blob store was used %d times before authorization", store.calls)
+				}
+			})
+		}
+	}
+}
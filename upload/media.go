@@ -0,0 +1,180 @@
+package upload
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	pb "wegugin/genproto/cruds"
+	"wegugin/storage/blob"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	mediaVideo    = "video"
+	mediaDocument = "document"
+
+	visibilityPublic  = "public"
+	visibilityPrivate = "private"
+)
+
+// sniffLen - http.DetectContentType ko'radigan bayt soni
+const sniffLen = 512
+
+// mediaTypes - tur bo'yicha fayl boshidan aniqlangan MIME turlari va saqlanadigan kengaytma
+var mediaTypes = map[string]map[string]string{
+	mediaVideo: {
+		"video/mp4":  ".mp4",
+		"video/webm": ".webm",
+	},
+	mediaDocument: {
+		"application/pdf": ".pdf",
+		"image/jpeg":      ".jpg",
+		"image/png":       ".png",
+	},
+}
+
+func init() {
+	// ServeFile Content-Type ni kengaytmadan oladi, standart jadvalda videolar yo'q
+	_ = mime.AddExtensionType(".mp4", "video/mp4")
+	_ = mime.AddExtensionType(".webm", "video/webm")
+}
+
+// UploadCarMedia - POST /v1/cars/{car_id}/media/upload?kind=video|document&visibility=public|private.
+// Video va PDF hujjatlar qayta kodlanmaydi, to'g'ridan-to'g'ri saqlashga oqim bilan yoziladi.
+// Hujjatlar standart bo'yicha yopiq, videolar ochiq
+func (h *Handler) UploadCarMedia(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := requestContext(r)
+	_, outbound := runtime.MarshalerForRequest(h.mux, r)
+
+	// 1. Validatsiya
+	carID, err := uuid.Parse(pathParams["car_id"])
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "invalid car ID format"))
+		return
+	}
+	kind := r.URL.Query().Get("kind")
+	var maxSize int64
+	visibility := visibilityPublic
+	switch kind {
+	case mediaVideo:
+		maxSize = h.maxVideoSize
+	case mediaDocument:
+		maxSize = h.maxDocumentSize
+		visibility = visibilityPrivate
+	default:
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "kind must be video or document"))
+		return
+	}
+	if v := r.URL.Query().Get("visibility"); v != "" {
+		if v != visibilityPublic && v != visibilityPrivate {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "visibility must be public or private"))
+			return
+		}
+		visibility = v
+	}
+
+	// 2. Ruxsat (tana o'qilishidan oldin) va fayl qismini topish
+	if !h.authorizeCar(w, r, outbound, carID) {
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize+1<<20)
+	part, err := filePart(r)
+	if err != nil {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	defer part.Close()
+
+	// 3. Haqiqiy tur fayl boshidan aniqlanadi, mijoz yuborgan Content-Type ishlatilmaydi
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(part, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument, "empty or unreadable file"))
+		return
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	ext, ok := mediaTypes[kind][contentType]
+	if !ok {
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.InvalidArgument,
+			fmt.Sprintf("unsupported %s type %s", kind, contentType)))
+		return
+	}
+
+	body := &limitedReader{r: io.MultiReader(bytes.NewReader(head), part), remaining: maxSize}
+	tooLarge := status.Error(codes.InvalidArgument, fmt.Sprintf("file exceeds %d bytes", maxSize))
+
+	// 4. Rasm ko'rinishidagi hujjatlar (skanlar, fotosuratlar) ham EXIF (GPS, kamera) dan tozalanadi
+	var content io.Reader = body
+	var clean *cleanImage
+	if kind == mediaDocument && contentType != "application/pdf" {
+		clean, err = h.sanitize(body)
+		if errors.Is(err, errTooLarge) {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, tooLarge)
+			return
+		}
+		if err != nil {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, h.sanitizeError(err))
+			return
+		}
+		content, contentType, ext = bytes.NewReader(clean.data), clean.contentType, clean.ext
+	}
+
+	// 5. Blob saqlash. Yopiq fayllar private/ ostida, ular faqat imzolangan URL bilan beriladi
+	key := objectKey(carID.String(), ext)
+	if visibility == visibilityPrivate {
+		key = blob.PrivatePrefix + key
+	}
+	hash := sha256.New()
+	if err := h.store.Put(ctx, key, io.TeeReader(content, hash), contentType); err != nil {
+		h.deleteBlob(key)
+		if errors.Is(err, errTooLarge) {
+			runtime.HTTPError(ctx, h.mux, outbound, w, r, tooLarge)
+			return
+		}
+		h.logger.Error("failed to store media", "key", key, "error", err)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, status.Error(codes.Internal, "failed to store file"))
+		return
+	}
+
+	sizeBytes := maxSize - body.remaining
+	if clean != nil {
+		sizeBytes = int64(len(clean.data))
+	}
+
+	// 6. Yozuv yaratish (tegishlilik va cheklovlar servisda yana tekshiriladi)
+	rctx, err := runtime.AnnotateContext(ctx, h.mux, r, pb.CrudsService_AddCarMedia_FullMethodName)
+	if err != nil {
+		h.deleteBlob(key)
+		runtime.HTTPError(ctx, h.mux, outbound, w, r, err)
+		return
+	}
+	var md runtime.ServerMetadata
+	item, err := h.client.AddCarMedia(rctx, &pb.AddCarMediaRequest{
+		CarId:      carID.String(),
+		Kind:       kind,
+		Filename:   key,
+		MimeType:   contentType,
+		Visibility: visibility,
+		SizeBytes:  sizeBytes,
+		Checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+	rctx = runtime.NewServerMetadataContext(rctx, md)
+	if err != nil {
+		h.deleteBlob(key)
+		runtime.HTTPError(rctx, h.mux, outbound, w, r, err)
+		return
+	}
+
+	runtime.ForwardResponseMessage(rctx, h.mux, outbound, w, r, item)
+}
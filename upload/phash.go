@@ -0,0 +1,71 @@
+package upload
+
+import (
+	"image"
+	"math"
+	"sort"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	// phashSample - xesh hisoblanadigan kulrang rasm o'lchami
+	phashSample = 32
+	// phashSize - DCT ning past chastotali qismi (8x8 = 64 bit)
+	phashSize = 8
+)
+
+// phashCos - DCT koeffitsiyentlari: cos((2x+1)uπ / 2N)
+var phashCos = func() (c [phashSize][phashSample]float64) {
+	for u := 0; u < phashSize; u++ {
+		for x := 0; x < phashSample; x++ {
+			c[u][x] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / (2 * phashSample))
+		}
+	}
+	return c
+}()
+
+// perceptualHash - DCT asosidagi 64 bitli pHash. O'lcham, siqish va ranglar biroz
+// o'zgargan nusxalar yaqin xesh beradi, farq Hamming masofasi bilan o'lchanadi
+func perceptualHash(src image.Image) uint64 {
+	// 1. Kichraytirilgan kulrang nusxa
+	gray := image.NewGray(image.Rect(0, 0, phashSample, phashSample))
+	draw.CatmullRom.Scale(gray, gray.Bounds(), src, src.Bounds(), draw.Src, nil)
+
+	// 2. Ajraladigan DCT: avval qatorlar, keyin ustunlar bo'yicha faqat birinchi 8 koeffitsiyent
+	var rows [phashSample][phashSize]float64
+	for y := 0; y < phashSample; y++ {
+		for u := 0; u < phashSize; u++ {
+			var sum float64
+			for x := 0; x < phashSample; x++ {
+				sum += float64(gray.Pix[y*gray.Stride+x]) * phashCos[u][x]
+			}
+			rows[y][u] = sum
+		}
+	}
+	var coeffs [phashSize * phashSize]float64
+	for v := 0; v < phashSize; v++ {
+		for u := 0; u < phashSize; u++ {
+			var sum float64
+			for y := 0; y < phashSample; y++ {
+				sum += rows[y][u] * phashCos[v][y]
+			}
+			coeffs[v*phashSize+u] = sum
+		}
+	}
+
+	// 3. Har bir koeffitsiyent medianadan katta bo'lsa bit 1. DC (o'rtacha yorqinlik)
+	// medianani siljitmasligi uchun hisobga olinmaydi
+	sorted := make([]float64, len(coeffs)-1)
+	copy(sorted, coeffs[1:])
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, c := range coeffs {
+		if i > 0 && c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
@@ -0,0 +1,95 @@
+package upload
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+)
+
+// originalQuality - qayta kodlangan asl JPEG sifati
+const originalQuality = 90
+
+// errInvalidImage - fayl qabul qilinadigan rasm emas yoki buzilgan
+var errInvalidImage = errors.New("invalid image")
+
+// allowedTypes - mijoz yuborgan Content-Type emas, fayl boshidan aniqlangan tur tekshiriladi
+var allowedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// cleanImage - tekshirilgan va metadata dan tozalangan rasm
+type cleanImage struct {
+	data        []byte
+	contentType string
+	ext         string
+	img         image.Image
+	width       int
+	height      int
+	checksum    string
+	phash       uint64
+}
+
+// sanitize - faylni o'qib, turini va o'lchamlarini tekshiradi hamda qayta kodlaydi.
+// Qayta kodlashda EXIF (GPS, kamera ma'lumotlari) yangi faylga o'tmaydi
+func (h *Handler) sanitize(r io.Reader) (*cleanImage, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Haqiqiy tur
+	contentType := http.DetectContentType(data)
+	if !allowedTypes[contentType] {
+		return nil, fmt.Errorf("%w: unsupported file type %s, expected JPEG, PNG, GIF or WebP", errInvalidImage, contentType)
+	}
+
+	// 2. O'lchamlar to'liq dekodlashdan oldin tekshiriladi (katta piksel sonli fayllar xotirani to'ldirmasligi uchun)
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: corrupted image", errInvalidImage)
+	}
+	if cfg.Width > h.maxDimension || cfg.Height > h.maxDimension {
+		return nil, fmt.Errorf("%w: image is %dx%d, at most %d px per side allowed",
+			errInvalidImage, cfg.Width, cfg.Height, h.maxDimension)
+	}
+
+	// 3. To'liq dekodlash, buzilgan fayllar shu yerda rad etiladi
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: corrupted image", errInvalidImage)
+	}
+	if format == "jpeg" {
+		// EXIF olib tashlanadi, shuning uchun burilish piksellarga qo'llanadi
+		src = orient(src, jpegOrientation(data))
+	}
+
+	// 4. Qayta kodlash: JPEG JPEG bo'lib qoladi, qolganlari shaffoflik saqlanishi uchun PNG
+	out := &cleanImage{img: src, width: src.Bounds().Dx(), height: src.Bounds().Dy()}
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, src, &jpeg.Options{Quality: originalQuality})
+		out.contentType, out.ext = "image/jpeg", ".jpg"
+	} else {
+		err = png.Encode(&buf, src)
+		out.contentType, out.ext = "image/png", ".png"
+	}
+	if err != nil {
+		return nil, fmt.Errorf("encode image: %w", err)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	out.data = buf.Bytes()
+	out.checksum = hex.EncodeToString(sum[:])
+	out.phash = perceptualHash(src)
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"wegugin/storage/blob"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// variantQuality - o'lchamlar uchun JPEG sifati
+const variantQuality = 82
+
+// storeVariants - dekodlangan asl rasmdan blob.Variants dagi har bir o'lchamni
+// JPEG sifatida asl fayl yoniga yozadi. Saqlangan o'lcham nomlarini qaytaradi
+func (h *Handler) storeVariants(ctx context.Context, key string, src image.Image) ([]string, error) {
+	names := make([]string, 0, len(blob.Variants))
+	for _, v := range blob.Variants {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resize(src, v.MaxSide), &jpeg.Options{Quality: variantQuality}); err != nil {
+			return names, fmt.Errorf("encode %s: %w", v.Name, err)
+		}
+		if err := h.store.Put(ctx, blob.VariantKey(key, v.Name), &buf, "image/jpeg"); err != nil {
+			return names, fmt.Errorf("store %s: %w", v.Name, err)
+		}
+		names = append(names, v.Name)
+	}
+	return names, nil
+}
+
+// resize - uzun tomoni maxSide dan oshmaydigan nusxa (kichik rasmlar kattalashtirilmaydi).
+// Shaffof joylar oq fon bilan to'ldiriladi, chunki JPEG da alfa kanal yo'q
+func resize(src image.Image, maxSide int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > maxSide || h > maxSide {
+		if w >= h {
+			w, h = maxSide, max(1, h*maxSide/w)
+		} else {
+			w, h = max(1, w*maxSide/h), maxSide
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}